@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	configv2 "github.com/daishe/impostorcmd/config/v2"
+	"github.com/daishe/impostorcmd/internal/action"
+	"github.com/daishe/impostorcmd/internal/config"
+	"github.com/daishe/impostorcmd/internal/descriptor"
+)
+
+type configOptions struct {
+}
+
+func configCmd(r *rootOptions) *cobra.Command {
+	o := &configOptions{}
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "work with configuration files",
+		Long:  "Work with configuration files.",
+	}
+	cmd.AddCommand(configSchemaCmd(r, o))
+	cmd.AddCommand(configValidateCmd(r, o))
+	cmd.AddCommand(configMigrateCmd(r, o))
+	cmd.AddCommand(configShowCmd(r, o))
+	return cmd
+}
+
+type configSchemaOptions struct {
+	version string
+}
+
+func configSchemaCmd(r *rootOptions, c *configOptions) *cobra.Command {
+	o := &configSchemaOptions{}
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "print JSON Schema of configuration files",
+		Long:  "Print JSON Schema of configuration files, that can be used by editors to validate and autocomplete configuration.",
+		Args:  cobra.NoArgs,
+	}
+	cmd.Flags().StringVar(&o.version, "version", "", "version of configuration files to print schema of: v1 or v2 (the latest version, when unset)")
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		checkErr(cmd, configSchemaCmdRun(cmd, r, o, args))
+	}
+	return cmd
+}
+
+func configSchemaCmdRun(cmd *cobra.Command, r *rootOptions, o *configSchemaOptions, args []string) error {
+	schema, err := config.JSONSchema(o.version)
+	if err != nil {
+		return fmt.Errorf("generating schema: %w", err)
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), string(schema))
+	return nil
+}
+
+type configValidateOptions struct {
+	configFormat string
+}
+
+func configValidateCmd(r *rootOptions, c *configOptions) *cobra.Command {
+	o := &configValidateOptions{}
+	cmd := &cobra.Command{
+		Use:   "validate [option]... configuration",
+		Short: "validate configuration",
+		Long:  "Validate configuration file (or directory of configuration fragments) together with all included files. Besides structure, it checks whether commands and impostor commands of targets can be resolved and whether any target is defined more than once.",
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.Flags().StringVar(&o.configFormat, "config-format", "", "format of configuration file: json, yaml, toml or textproto (detected by file extension, when unset)")
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		checkErr(cmd, configValidateCmdRun(cmd, r, o, args))
+	}
+	return cmd
+}
+
+func configValidateCmdRun(cmd *cobra.Command, r *rootOptions, o *configValidateOptions, args []string) error {
+	format := config.Format("")
+	if o.configFormat != "" {
+		f, err := config.ParseFormat(o.configFormat)
+		if err != nil {
+			return fmt.Errorf("parsing 'config-format' flag value: %w", err)
+		}
+		format = f
+	}
+
+	diagnostics := config.Validate(args[0], format, checkConfigTarget)
+	for _, d := range diagnostics {
+		fmt.Fprintln(cmd.OutOrStdout(), d)
+	}
+	if len(diagnostics) > 0 {
+		return fmt.Errorf("configuration is invalid: %d problem(s) found", len(diagnostics))
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Configuration %s is valid\n", args[0])
+	return nil
+}
+
+type configMigrateOptions struct {
+	configFormat string
+	outputFormat string
+	output       string
+	inPlace      bool
+}
+
+func configMigrateCmd(r *rootOptions, c *configOptions) *cobra.Command {
+	o := &configMigrateOptions{}
+	cmd := &cobra.Command{
+		Use:   "migrate [option]... configuration",
+		Short: "migrate configuration to the latest version",
+		Long:  "Rewrite configuration file of version v1 to the latest version, preserving its meaning. Included files are not migrated, migrate each of them separately (files of different versions may include each other).",
+		Args:  cobra.ExactArgs(1),
+	}
+	cmd.Flags().StringVar(&o.configFormat, "config-format", "", "format of configuration file: json, yaml, toml or textproto (detected by file extension, when unset)")
+	cmd.Flags().StringVar(&o.outputFormat, "output-format", "", "format of migrated configuration (the same as the format of configuration file, when unset)")
+	cmd.Flags().StringVarP(&o.output, "output", "o", "", "file to write migrated configuration to (standard output, when unset)")
+	cmd.Flags().BoolVar(&o.inPlace, "in-place", false, "overwrite configuration file with migrated configuration")
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		checkErr(cmd, configMigrateCmdRun(cmd, r, o, args))
+	}
+	return cmd
+}
+
+func configMigrateCmdRun(cmd *cobra.Command, r *rootOptions, o *configMigrateOptions, args []string) error {
+	if o.inPlace && o.output != "" {
+		return fmt.Errorf("'in-place' flag specified together with 'output' flag")
+	}
+	configPath := args[0]
+	format := config.FormatFromPath(configPath)
+	if o.configFormat != "" {
+		f, err := config.ParseFormat(o.configFormat)
+		if err != nil {
+			return fmt.Errorf("parsing 'config-format' flag value: %w", err)
+		}
+		format = f
+	}
+	outputFormat := format
+	if o.outputFormat != "" {
+		f, err := config.ParseFormat(o.outputFormat)
+		if err != nil {
+			return fmt.Errorf("parsing 'output-format' flag value: %w", err)
+		}
+		outputFormat = f
+	}
+
+	cfgBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		return fmt.Errorf("reading configuration file: %w", err)
+	}
+	migrated, err := config.Migrate(cfgBytes, format, outputFormat)
+	if err != nil {
+		return fmt.Errorf("migrating %s: %w", configPath, err)
+	}
+
+	output := o.output
+	if o.inPlace {
+		output = configPath
+	}
+	if output == "" {
+		_, err := cmd.OutOrStdout().Write(migrated)
+		return err
+	}
+	if err := writeFileAtomically(output, migrated); err != nil {
+		return fmt.Errorf("writing migrated configuration: %w", err)
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "Migrated %s to %s\n", configPath, output)
+	return nil
+}
+
+type configShowOptions struct {
+	config       string
+	configFormat string
+}
+
+func configShowCmd(r *rootOptions, c *configOptions) *cobra.Command {
+	o := &configShowOptions{}
+	cmd := &cobra.Command{
+		Use:   "show [option]...",
+		Short: "print effective configuration",
+		Long:  "Print effective configuration, merged from all configuration files, as a single YAML configuration with variables expanded and includes resolved. Every target is annotated with the file it came from. Unless 'config' flag is given, the default configuration is shown, merged from files listed in " + config.ConfigEnv + " environment variable or, when it is unset, from configuration directories " + config.SystemConfigDir() + " and then $XDG_CONFIG_HOME/impostorcmd (config file with any supported extension, followed by config.d directory of configuration fragments, in each).",
+		Args:  cobra.NoArgs,
+	}
+	cmd.Flags().StringVar(&o.config, "config", "", "configuration file or directory of configuration fragments to show instead of the default configuration")
+	cmd.Flags().StringVar(&o.configFormat, "config-format", "", "format of configuration file: json, yaml, toml or textproto (detected by file extension, when unset)")
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		checkErr(cmd, configShowCmdRun(cmd, r, o, args))
+	}
+	return cmd
+}
+
+func configShowCmdRun(cmd *cobra.Command, r *rootOptions, o *configShowOptions, args []string) error {
+	if o.configFormat != "" && o.config == "" {
+		return fmt.Errorf("'config-format' flag specified without 'config' flag")
+	}
+	format := config.Format("")
+	if o.configFormat != "" {
+		f, err := config.ParseFormat(o.configFormat)
+		if err != nil {
+			return fmt.Errorf("parsing 'config-format' flag value: %w", err)
+		}
+		format = f
+	}
+	sources := []string{o.config}
+	if o.config == "" {
+		s, err := config.DefaultSources()
+		if err != nil {
+			return fmt.Errorf("discovering default configuration: %w", err)
+		}
+		if len(s) == 0 {
+			return fmt.Errorf("no default configuration found")
+		}
+		sources = s
+	}
+
+	loaded, err := config.Load(sources, format)
+	if err != nil {
+		return err
+	}
+	shown, err := config.Show(loaded, sources)
+	if err != nil {
+		return fmt.Errorf("formatting configuration: %w", err)
+	}
+	_, err = cmd.OutOrStdout().Write(shown)
+	return err
+}
+
+// writeFileAtomically replaces contents of the file under the given path (keeping its permissions, if it exists), so that readers see either old or new contents.
+func writeFileAtomically(path string, data []byte) error {
+	perm := os.FileMode(0o644)
+	if stat, err := os.Stat(path); err == nil {
+		perm = stat.Mode().Perm()
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op after successful rename
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// checkConfigTarget checks whether command and handler of the given target can be resolved.
+func checkConfigTarget(t *configv2.Target) error {
+	errs := []error(nil)
+	if _, err := descriptor.FromTargetExpanded(t); err != nil && !(t.Optional && descriptor.IsCommandMissing(err)) {
+		errs = append(errs, err)
+	}
+	if err := action.CheckHandler(descriptor.FromTargetUnresolved(t)); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/daishe/impostorcmd/internal/action"
+	"github.com/daishe/impostorcmd/internal/descriptor"
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+type inspectOptions struct {
+	targets targetsOptions
+}
+
+func inspectCmd(r *rootOptions) *cobra.Command {
+	o := &inspectOptions{}
+	cmd := &cobra.Command{
+		Use:     "inspect [option]... target-command...",
+		Aliases: []string{"list"},
+		Short:   "show impostoring scheme",
+		Long:    "Show impostoring setup of command or commands.",
+	}
+	o.targets.addFlags(cmd)
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		checkErr(cmd, inspectCmdRun(cmd, r, o, args))
+	}
+	return cmd
+}
+
+func inspectCmdRun(cmd *cobra.Command, r *rootOptions, o *inspectOptions, args []string) (err error) {
+
+	targetDescs, err := o.targets.targetDescriptors(cmd, args, targetDescriptorByCmdArgs)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range targetDescs {
+		signed, err := action.DescribeSigned(t.OriginalCmd)
+		if err != nil {
+			if errors.As(err, &descriptor.ErrorNoDescriptor{}) {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s: not an impostor\n", targetName(t))
+				continue
+			}
+			return fmt.Errorf("inspecting target %s failed: %w", t.OriginalCmd, err)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%s: impostor\n", targetName(t))
+		printDescriptor(cmd.OutOrStdout(), "  ", signed.Descriptor)
+		printSignature(cmd.OutOrStdout(), "  ", signed)
+	}
+	return nil
+}
+
+func printDescriptor(w io.Writer, indent string, desc *impostordatav1.TargetDescriptor) {
+	printHandler(w, indent, desc.ImpostorCmd, desc.ImpostorCmdArgs, desc.ImpostorCmdArgsTemplate, desc.Builtin, desc.Script)
+	printPin(w, indent, desc.ImpostorPin)
+	fmt.Fprintf(w, "%sinclude argument #0: %t\n", indent, desc.IncludeArg_0)
+	for _, name := range sortedKeys(desc.Env) {
+		fmt.Fprintf(w, "%senvironment variable %s: %s\n", indent, name, strconv.Quote(desc.Env[name]))
+	}
+	if desc.DeclineExitCode != 0 {
+		fmt.Fprintf(w, "%sdecline exit code: %d\n", indent, desc.DeclineExitCode)
+	}
+	if desc.DeclineControlFd {
+		fmt.Fprintf(w, "%sdecline through control file descriptor: %t\n", indent, desc.DeclineControlFd)
+	}
+	for i, r := range desc.Rules {
+		fmt.Fprintf(w, "%srule #%d:\n", indent, i+1)
+		printRuleMatch(w, indent+"  ", r.Match)
+		printHandler(w, indent+"  ", r.ImpostorCmd, r.ImpostorCmdArgs, r.ImpostorCmdArgsTemplate, r.Builtin, r.Script)
+		printPin(w, indent+"  ", r.ImpostorPin)
+	}
+	fmt.Fprintf(w, "%soriginal command: %s\n", indent, desc.OriginalCmd)
+	fmt.Fprintf(w, "%sstack depth: %d\n", indent, desc.StackDepth)
+	if fp := desc.OriginalFingerprint; fp != nil {
+		fmt.Fprintf(w, "%soriginal command sha256: %s\n", indent, hex.EncodeToString(fp.Sha256))
+		fmt.Fprintf(w, "%soriginal command size: %d\n", indent, fp.Size)
+		fmt.Fprintf(w, "%soriginal command mode: %s\n", indent, os.FileMode(fp.Mode))
+		if fp.Owner != nil {
+			fmt.Fprintf(w, "%soriginal command owner: %d:%d\n", indent, fp.Owner.Uid, fp.Owner.Gid)
+		}
+		fmt.Fprintf(w, "%soriginal command mtime: %s\n", indent, time.Unix(0, fp.MtimeUnixNano).Format(time.RFC3339Nano))
+	}
+	fmt.Fprintf(w, "%sverify original on invocation: %t\n", indent, desc.VerifyOriginal)
+	if p := desc.Provenance; p != nil {
+		printIfSet := func(name, value string) {
+			if value != "" {
+				fmt.Fprintf(w, "%s%s: %s\n", indent, name, value)
+			}
+		}
+		if p.InstalledAtUnixNano != 0 {
+			fmt.Fprintf(w, "%sinstalled at: %s\n", indent, time.Unix(0, p.InstalledAtUnixNano).Format(time.RFC3339))
+		}
+		printIfSet("installed with impostorcmd version", p.ImpostorcmdVersion)
+		printIfSet("installed with impostorcmd commit", p.ImpostorcmdCommit)
+		if p.User != "" || p.Uid != "" {
+			fmt.Fprintf(w, "%sinstalled by user: %s (uid %s)\n", indent, p.User, p.Uid)
+		}
+		printIfSet("installed by sudo user", p.SudoUser)
+		printIfSet("installed on host", p.Hostname)
+		printIfSet("installed from configuration", p.ConfigPath)
+		printIfSet("expanded from command pattern", p.CmdPattern)
+		printIfSet("description", p.Description)
+		printIfSet("owner", p.Owner)
+	}
+}
+
+func quoteAll(l []string) []string {
+	quoted := make([]string, 0, len(l))
+	for _, s := range l {
+		quoted = append(quoted, strconv.Quote(s))
+	}
+	return quoted
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func printHandler(w io.Writer, indent string, impostorCmd string, impostorCmdArgs []string, impostorCmdArgsTemplate []string, builtin *impostordatav1.BuiltinHandler, script *impostordatav1.ScriptHandler) {
+	switch {
+	case builtin != nil:
+		fmt.Fprintf(w, "%sbuiltin handler: %s\n", indent, builtin.Name)
+		for _, name := range sortedKeys(builtin.Options) {
+			fmt.Fprintf(w, "%sbuiltin handler option %s: %s\n", indent, name, strconv.Quote(builtin.Options[name]))
+		}
+		for i, r := range builtin.Rewrites {
+			fmt.Fprintf(w, "%sargument rewrite #%d: %s\n", indent, i+1, describeRewrite(r))
+		}
+	case script != nil:
+		if len(script.Interpreter) == 0 {
+			fmt.Fprintf(w, "%sscript interpreter: default\n", indent)
+		} else {
+			fmt.Fprintf(w, "%sscript interpreter: [%s]\n", indent, strings.Join(quoteAll(script.Interpreter), ", "))
+		}
+		fmt.Fprintf(w, "%sscript source: %s\n", indent, strconv.Quote(script.Source))
+	default:
+		fmt.Fprintf(w, "%simpostor command: %s\n", indent, impostorCmd)
+		if len(impostorCmdArgsTemplate) > 0 {
+			fmt.Fprintf(w, "%simpostor arguments template: [%s]\n", indent, strings.Join(quoteAll(impostorCmdArgsTemplate), ", "))
+		} else {
+			fmt.Fprintf(w, "%simpostor arguments: [%s]\n", indent, strings.Join(quoteAll(impostorCmdArgs), ", "))
+		}
+	}
+}
+
+func describeRewrite(r *impostordatav1.ArgumentRewrite) string {
+	switch k := r.Kind.(type) {
+	case *impostordatav1.ArgumentRewrite_Insert:
+		where := "before all arguments"
+		if k.Insert.AtEnd {
+			where = "after all arguments"
+		}
+		return fmt.Sprintf("insert [%s] %s", strings.Join(quoteAll(k.Insert.Args), ", "), where)
+	case *impostordatav1.ArgumentRewrite_Drop:
+		if k.Drop.WithValue {
+			return fmt.Sprintf("drop flags [%s] with their values", strings.Join(quoteAll(k.Drop.Flags), ", "))
+		}
+		return fmt.Sprintf("drop flags [%s]", strings.Join(quoteAll(k.Drop.Flags), ", "))
+	case *impostordatav1.ArgumentRewrite_Replace:
+		return fmt.Sprintf("replace %s with %s", strconv.Quote(k.Replace.Regex), strconv.Quote(k.Replace.Replacement))
+	case *impostordatav1.ArgumentRewrite_RemapSubcommand:
+		return fmt.Sprintf("remap subcommand %s to [%s]", strconv.Quote(k.RemapSubcommand.From), strings.Join(quoteAll(k.RemapSubcommand.To), ", "))
+	}
+	return "none"
+}
+
+func printPin(w io.Writer, indent string, pin *impostordatav1.ImpostorPin) {
+	if pin == nil {
+		return
+	}
+	fmt.Fprintf(w, "%simpostor command pinned path: %s\n", indent, pin.Path)
+	fmt.Fprintf(w, "%simpostor command pinned sha256: %s\n", indent, hex.EncodeToString(pin.Sha256))
+}
+
+func printRuleMatch(w io.Writer, indent string, m *impostordatav1.RuleMatch) {
+	if m.GetArgsRegex() != "" {
+		fmt.Fprintf(w, "%smatch arguments regular expression: %s\n", indent, strconv.Quote(m.ArgsRegex))
+	}
+	if len(m.GetArgsGlob()) > 0 {
+		fmt.Fprintf(w, "%smatch leading arguments: [%s]\n", indent, strings.Join(quoteAll(m.ArgsGlob), ", "))
+	}
+	for _, name := range m.GetEnvSet() {
+		fmt.Fprintf(w, "%smatch environment variable %s: set\n", indent, name)
+	}
+	for _, name := range sortedKeys(m.GetEnvEquals()) {
+		fmt.Fprintf(w, "%smatch environment variable %s: %s\n", indent, name, strconv.Quote(m.EnvEquals[name]))
+	}
+	if len(m.GetCwdPrefix()) > 0 {
+		fmt.Fprintf(w, "%smatch working directory within any of: [%s]\n", indent, strings.Join(quoteAll(m.CwdPrefix), ", "))
+	}
+	if len(m.GetUid()) > 0 {
+		fmt.Fprintf(w, "%smatch user ID: %v\n", indent, m.Uid)
+	}
+	if len(m.GetGid()) > 0 {
+		fmt.Fprintf(w, "%smatch group ID: %v\n", indent, m.Gid)
+	}
+	if len(m.GetParent()) > 0 {
+		fmt.Fprintf(w, "%smatch parent process: [%s]\n", indent, strings.Join(quoteAll(m.Parent), ", "))
+	}
+	if m != nil && m.StdinTty != nil {
+		fmt.Fprintf(w, "%smatch standard input is terminal: %t\n", indent, *m.StdinTty)
+	}
+	if m != nil && m.StdoutTty != nil {
+		fmt.Fprintf(w, "%smatch standard output is terminal: %t\n", indent, *m.StdoutTty)
+	}
+}
+
+func printSignature(w io.Writer, indent string, signed *descriptor.Signed) {
+	if signed.Signature == nil {
+		fmt.Fprintf(w, "%ssignature: none\n", indent)
+		return
+	}
+	fmt.Fprintf(w, "%ssignature algorithm: %s\n", indent, signed.Signature.Algorithm)
+	fmt.Fprintf(w, "%ssigner public key: %s\n", indent, base64.StdEncoding.EncodeToString(signed.Signature.PublicKey))
+	if err := signed.Verify(); err != nil {
+		fmt.Fprintf(w, "%ssignature: %v\n", indent, err)
+	} else {
+		fmt.Fprintf(w, "%ssignature: valid\n", indent)
+	}
+}
@@ -3,34 +3,43 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
-	"strings"
 
 	"github.com/spf13/cobra"
 
-	configv1 "github.com/daishe/impostorcmd/config/v1"
+	configv2 "github.com/daishe/impostorcmd/config/v2"
 	"github.com/daishe/impostorcmd/internal/action"
-	"github.com/daishe/impostorcmd/internal/config"
 	"github.com/daishe/impostorcmd/internal/descriptor"
 	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
 )
 
 type installOptions struct {
-	json        string
-	config      string
-	includeArg0 bool
+	targets        targetsOptions
+	includeArg0    bool
+	verifyOriginal bool
+	description    string
+	owner          string
+	existing       string
+	signKey        string
+	pinImpostor    bool
+	lock           action.LockOptions
 }
 
 func installCmd(r *rootOptions) *cobra.Command {
-	o := &installOptions{}
+	o := &installOptions{targets: targetsOptions{install: true}}
 	cmd := &cobra.Command{
 		Use:   "install [option]... target-command impostor-command [argument]...",
 		Short: "setup impostoring scheme",
 		Long:  "Start impostoring command or commands.",
 	}
-	cmd.Flags().StringVar(&o.json, "json", "", "JSON setup description for single target")
-	cmd.Flags().StringVar(&o.config, "config", "", "JSON configuration file containing setup description")
+	o.targets.addFlags(cmd)
 	cmd.Flags().BoolVar(&o.includeArg0, "include-arg-0", false, "include argument #0 from original command when invoking impostor command")
+	cmd.Flags().BoolVar(&o.verifyOriginal, "verify-original", false, "verify original command against fingerprint captured during install on every impostor invocation")
+	cmd.Flags().StringVar(&o.description, "description", "", "free-form description, why the command is impostored")
+	cmd.Flags().StringVar(&o.owner, "owner", "", "free-form owner (person, team, etc.) responsible for the impostor")
+	cmd.Flags().StringVar(&o.existing, "existing", action.ExistingImpostorRefuse.String(), "what to do when target already is an impostor: refuse, replace (swap the existing impostor setup) or stack (impostor the existing impostor)")
+	cmd.Flags().BoolVar(&o.pinImpostor, "pin-impostor", false, "resolve impostor command during install and refuse to run it, when its path or contents change")
+	cmd.Flags().StringVar(&o.signKey, "sign-key", "", "path to PEM encoded ed25519 private key (as generated by 'openssl genpkey -algorithm ed25519') used to sign impostor descriptors")
+	addLockFlags(cmd, &o.lock)
 	cmd.Run = func(cmd *cobra.Command, args []string) {
 		checkErr(cmd, installCmdRun(cmd, r, o, args))
 	}
@@ -38,103 +47,45 @@ func installCmd(r *rootOptions) *cobra.Command {
 }
 
 func installCmdRun(cmd *cobra.Command, r *rootOptions, o *installOptions, args []string) (err error) {
-	isByInlineJson, isByConfig, isByArgs := o.json != "", o.config != "", len(args) > 0
-	trueCount := func(x ...bool) (count int) {
-		for _, v := range x {
-			if v {
-				count++
-			}
-		}
-		return count
+	existingPolicy, err := action.ParseExistingImpostorPolicy(o.existing)
+	if err != nil {
+		return fmt.Errorf("parsing 'existing' flag value: %w", err)
 	}
-
-	switch {
-	case trueCount(isByArgs, isByInlineJson, isByConfig) == 0:
-		return fmt.Errorf("no arguments, 'json' flag nor 'config' flag specified")
-	case trueCount(isByArgs, isByInlineJson, isByConfig) == 2:
-		l := make([]string, 0, 2)
-		if isByArgs {
-			l = append(l, "arguments")
-		}
-		if isByInlineJson {
-			l = append(l, "'json' flag")
+	installOpts := action.InstallOptions{Existing: existingPolicy, Version: appVersion, Commit: commitHash, PinImpostor: o.pinImpostor}
+	if o.signKey != "" {
+		if installOpts.SignKey, err = descriptor.LoadSigningKey(o.signKey); err != nil {
+			return err
 		}
-		if isByConfig {
-			l = append(l, "'config' flag")
-		}
-		return fmt.Errorf("%s specified together", strings.Join(l, " and "))
-	case trueCount(isByArgs, isByInlineJson, isByConfig) == 3:
-		return fmt.Errorf("arguments, 'json' flag and 'config' flag specified together")
 	}
 
-	targetDescs := []*impostordatav1.TargetDescriptor(nil)
-	switch {
-	case isByInlineJson:
-		targetDescs, err = targetDescriptorByJsonTarget(cmd.Context(), o.json)
-	case isByConfig:
-		targetDescs, err = targetDescriptorByConfigFile(cmd.Context(), o.config)
-	default: // isByArgs
-		targetDescs, err = targetDescriptorByInstallArgs(cmd.Context(), r, o, args)
-	}
+	targetDescs, err := o.targets.targetDescriptors(cmd, args, func(ctx context.Context, args []string) ([]*impostordatav1.TargetDescriptor, error) {
+		return targetDescriptorByInstallArgs(ctx, r, o, args)
+	})
 	if err != nil {
 		return err
 	}
-
-	undoAll := action.Compensate(nil)
-	wrapUndo := func(undo action.Compensate, cmd *cobra.Command, target *impostordatav1.TargetDescriptor) func() error {
-		return func() error {
-			if err := undo(); err != nil {
-				showErr(cmd, fmt.Errorf("undoing actions taken for target %s failed: %w", target.OriginalCmd, err))
-			} else {
-				fmt.Fprintf(cmd.OutOrStdout(), "Undone actions taken for target %s\n", target.OriginalCmd)
-			}
-			return nil
-		}
+	if err := action.CheckCycles(targetDescs); err != nil {
+		return fmt.Errorf("refusing to install impostors, that would recurse at runtime:\n%w", err)
 	}
 
-	for _, t := range targetDescs {
-		undo, err := action.Install(t)
-		undoAll.With(wrapUndo(undo, cmd, t))
-		if err != nil {
-			showErr(cmd, fmt.Errorf("installing target %s failed: %w", t.OriginalCmd, err))
-			showErr(cmd, undoAll.Run())
-			return fmt.Errorf("failure occurred while attempting to impostor target %s", t.OriginalCmd)
-		}
-		fmt.Fprintf(cmd.OutOrStdout(), "Installed impostor for target %s\n", t.OriginalCmd)
-	}
-	return nil
-}
-
-func targetDescriptorByJsonTarget(ctx context.Context, json string) ([]*impostordatav1.TargetDescriptor, error) {
-	target, err := config.UnmarshalAndValidateTarget([]byte(json))
+	unlock, err := lockTargets(cmd, o.lock, targetDescs)
 	if err != nil {
-		return nil, fmt.Errorf("parsing 'json' flag value: %w", err)
-	}
-	desc, err := descriptor.FromTarget(target)
-	if err != nil {
-		return nil, err
+		return err
 	}
-	return []*impostordatav1.TargetDescriptor{desc}, nil
-}
+	defer func() { showErr(cmd, unlock()) }()
 
-func targetDescriptorByConfigFile(ctx context.Context, configPath string) ([]*impostordatav1.TargetDescriptor, error) {
-	cfgBytes, err := os.ReadFile(configPath)
-	if err != nil {
-		return nil, fmt.Errorf("reading configuration file: %w", err)
-	}
-	cfg, err := config.UnmarshalAndValidateConfiguration(cfgBytes)
-	if err != nil {
-		return nil, err
-	}
-	descs := make([]*impostordatav1.TargetDescriptor, 0, len(cfg.Targets))
-	for i, t := range cfg.Targets {
-		desc, err := descriptor.FromTarget(t)
+	tx := &action.Transaction{}
+	for i, t := range targetDescs {
+		targetTx, err := action.Install(t, installOpts)
+		tx.Include(t.OriginalCmd, targetTx)
 		if err != nil {
-			return nil, fmt.Errorf("target #%d (%s): %w", i+1, t.Cmd, err)
+			showErr(cmd, fmt.Errorf("installing target %s failed: %w", t.OriginalCmd, err))
+			rollbackTargets(cmd, tx, targetDescs[i+1:])
+			return fmt.Errorf("failure occurred while attempting to impostor target %s", t.OriginalCmd)
 		}
-		descs = append(descs, desc)
+		fmt.Fprintf(cmd.OutOrStdout(), "Installed impostor for target %s\n", targetName(t))
 	}
-	return descs, nil
+	return commitTargets(cmd, tx)
 }
 
 func targetDescriptorByInstallArgs(ctx context.Context, r *rootOptions, o *installOptions, args []string) ([]*impostordatav1.TargetDescriptor, error) {
@@ -144,11 +95,18 @@ func targetDescriptorByInstallArgs(ctx context.Context, r *rootOptions, o *insta
 	if len(args) < 2 {
 		return nil, fmt.Errorf("too few arguments provided: missing impostor-command")
 	}
-	target := &configv1.Target{
-		Cmd:          args[0],
-		Impostor:     args[1],
-		ImpostorArgs: args[2:],
-		IncludeArg_0: o.includeArg0,
+	target := &configv2.Target{
+		Cmd: args[0],
+		Handler: &configv2.Handler{Kind: &configv2.Handler_External{External: &configv2.ExternalHandler{
+			Cmd:  args[1],
+			Args: args[2:],
+		}}},
+		Runtime: &configv2.RuntimeOptions{
+			IncludeArg_0:   o.includeArg0,
+			VerifyOriginal: o.verifyOriginal,
+		},
+		Description: o.description,
+		Owner:       o.owner,
 	}
 	desc, err := descriptor.FromTarget(target)
 	if err != nil {
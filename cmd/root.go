@@ -2,10 +2,15 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
+
+	"github.com/daishe/impostorcmd/internal/action"
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
 )
 
 type rootOptions struct {
@@ -20,10 +25,71 @@ func rootCmd() *cobra.Command {
 	}
 	cmd.AddCommand(installCmd(o))
 	cmd.AddCommand(uninstallCmd(o))
+	cmd.AddCommand(inspectCmd(o))
+	cmd.AddCommand(verifyCmd(o))
+	cmd.AddCommand(configCmd(o))
 	cmd.AddCommand(versionCmd(o))
 	return cmd
 }
 
+func addLockFlags(cmd *cobra.Command, o *action.LockOptions) {
+	cmd.Flags().BoolVar(&o.Wait, "wait", false, "wait for other impostorcmd processes operating on the same targets to finish")
+	cmd.Flags().DurationVar(&o.Timeout, "timeout", 0, "maximum time to wait for other impostorcmd processes, when used with 'wait' flag (0 means no limit)")
+}
+
+// lockTargets locks the given targets for the duration of the command. When locking is not supported on the current system, a warning is shown and targets are left unlocked, unless waiting for locks is requested.
+func lockTargets(cmd *cobra.Command, o action.LockOptions, targets []*impostordatav1.TargetDescriptor) (action.Unlock, error) {
+	if o.Timeout != 0 && !o.Wait {
+		return nil, fmt.Errorf("'timeout' flag specified without 'wait' flag")
+	}
+	if o.Timeout < 0 {
+		return nil, fmt.Errorf("'timeout' flag value cannot be negative")
+	}
+	paths := make([]string, 0, len(targets))
+	for _, t := range targets {
+		paths = append(paths, t.OriginalCmd)
+	}
+	unlock, err := action.Lock(paths, o)
+	if errors.As(err, &action.ErrorLockingUnsupported{}) && !o.Wait {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %v, targets are not protected against concurrent impostorcmd processes\n", err)
+		return func() error { return nil }, nil
+	}
+	return unlock, err
+}
+
+// rollbackTargets rolls back the given transaction, that contains a single step for every target, and reports which targets have been rolled back and which are left in an unknown state.
+func rollbackTargets(cmd *cobra.Command, tx *action.Transaction, notAttempted []*impostordatav1.TargetDescriptor) {
+	rolledBack, stuck := 0, 0
+	for _, s := range tx.Rollback() {
+		if s.Err != nil {
+			stuck++
+			showErr(cmd, fmt.Errorf("rolling back target %s failed, target is left in unknown state: %w", s.Name, s.Err))
+			continue
+		}
+		rolledBack++
+		fmt.Fprintf(cmd.OutOrStdout(), "Rolled back target %s\n", s.Name)
+	}
+	for _, t := range notAttempted {
+		fmt.Fprintf(cmd.OutOrStdout(), "Not attempted target %s\n", t.OriginalCmd)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Summary: %d rolled back, %d stuck, %d not attempted\n", rolledBack, stuck, len(notAttempted))
+}
+
+// commitTargets commits the given transaction, that contains a single step for every target, and reports targets for which finalization failed.
+func commitTargets(cmd *cobra.Command, tx *action.Transaction) error {
+	failed := []string(nil)
+	for _, s := range tx.Commit() {
+		if s.Err != nil {
+			failed = append(failed, s.Name)
+			showErr(cmd, fmt.Errorf("finalizing target %s failed: %w", s.Name, s.Err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failure occurred while finalizing targets %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
 func showErr(cmd *cobra.Command, msg interface{}) {
 	if msg != nil {
 		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %v\n", msg)
@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	configv2 "github.com/daishe/impostorcmd/config/v2"
+	"github.com/daishe/impostorcmd/internal/config"
+	"github.com/daishe/impostorcmd/internal/descriptor"
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+type targetsOptions struct {
+	json         string
+	config       string
+	configFormat string
+	profiles     []string
+	tags         []string
+
+	// install makes targets, whose conditions are not met, and missing optional targets skipped. Otherwise (when uninstalling or inspecting), every target is resolved and only missing ones are skipped, so that impostors can be found regardless of current conditions.
+	install bool
+}
+
+func (o *targetsOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.json, "json", "", "JSON setup description for single target")
+	cmd.Flags().StringVar(&o.config, "config", "", "configuration file containing setup description (JSON, YAML, TOML or protobuf text format) or directory of configuration fragments merged in lexical order (the default configuration is used, when neither arguments, 'json' nor 'config' flag is given)")
+	cmd.Flags().StringVar(&o.configFormat, "config-format", "", "format of configuration file: json, yaml, toml or textproto (detected by file extension, when unset)")
+	cmd.Flags().StringArrayVar(&o.profiles, "profile", nil, "select only targets belonging to the given configuration profile (may be repeated)")
+	cmd.Flags().StringArrayVar(&o.tags, "tag", nil, "select only targets with the given tag (may be repeated; targets matching any profile or tag are selected)")
+}
+
+// targetDescriptors returns descriptors of targets selected by either command arguments (using the given function), 'json' flag or 'config' flag. When none of them is given, the default configuration is used (see config.DefaultSources).
+func (o *targetsOptions) targetDescriptors(cmd *cobra.Command, args []string, byArgs func(context.Context, []string) ([]*impostordatav1.TargetDescriptor, error)) ([]*impostordatav1.TargetDescriptor, error) {
+	isByInlineJson, isByConfig, isByArgs := o.json != "", o.config != "", len(args) > 0
+	configPaths := []string{o.config}
+	if !isByInlineJson && !isByConfig && !isByArgs {
+		sources, err := config.DefaultSources()
+		if err != nil {
+			return nil, fmt.Errorf("discovering default configuration: %w", err)
+		}
+		if len(sources) == 0 {
+			return nil, fmt.Errorf("no arguments, 'json' flag nor 'config' flag specified and no default configuration found")
+		}
+		configPaths = sources
+		isByConfig = true
+	}
+	if err := checkTargetSources(isByArgs, isByInlineJson, isByConfig); err != nil {
+		return nil, err
+	}
+	if o.configFormat != "" && o.config == "" {
+		return nil, fmt.Errorf("'config-format' flag specified without 'config' flag")
+	}
+	if (len(o.profiles) > 0 || len(o.tags) > 0) && !isByConfig {
+		return nil, fmt.Errorf("'profile' or 'tag' flag specified together with arguments or 'json' flag")
+	}
+
+	switch {
+	case isByInlineJson:
+		return targetDescriptorByJsonTarget(cmd, o.json, o.install)
+	case isByConfig:
+		return targetDescriptorByConfigFile(cmd, configPaths, o.configFormat, o.profiles, o.tags, o.install)
+	}
+	return byArgs(cmd.Context(), args)
+}
+
+func checkTargetSources(isByArgs, isByInlineJson, isByConfig bool) error {
+	trueCount := func(x ...bool) (count int) {
+		for _, v := range x {
+			if v {
+				count++
+			}
+		}
+		return count
+	}
+
+	switch {
+	case trueCount(isByArgs, isByInlineJson, isByConfig) == 0:
+		return fmt.Errorf("no arguments, 'json' flag nor 'config' flag specified")
+	case trueCount(isByArgs, isByInlineJson, isByConfig) == 2:
+		l := make([]string, 0, 2)
+		if isByArgs {
+			l = append(l, "arguments")
+		}
+		if isByInlineJson {
+			l = append(l, "'json' flag")
+		}
+		if isByConfig {
+			l = append(l, "'config' flag")
+		}
+		return fmt.Errorf("%s specified together", strings.Join(l, " and "))
+	case trueCount(isByArgs, isByInlineJson, isByConfig) == 3:
+		return fmt.Errorf("arguments, 'json' flag and 'config' flag specified together")
+	}
+	return nil
+}
+
+func targetDescriptorByJsonTarget(cmd *cobra.Command, json string, install bool) ([]*impostordatav1.TargetDescriptor, error) {
+	target, err := config.UnmarshalAndValidateTarget([]byte(json))
+	if err != nil {
+		return nil, fmt.Errorf("parsing 'json' flag value: %w", err)
+	}
+	if install {
+		if err := config.ExpandCondition(target.When, config.Variables{}); err != nil {
+			return nil, fmt.Errorf("'json' flag value: %w", err)
+		}
+		met, reason, err := config.EvaluateCondition(target.When)
+		if err != nil {
+			return nil, fmt.Errorf("'json' flag value: evaluating condition: %w", err)
+		}
+		if !met {
+			fmt.Fprintf(cmd.OutOrStdout(), "Skipping target %s: condition not met: %s\n", target.Cmd, reason)
+			return nil, nil
+		}
+	}
+	if err := config.ExpandTarget(target, config.Variables{}); err != nil {
+		return nil, fmt.Errorf("'json' flag value: %w", err)
+	}
+	descs, err := descriptor.FromTargetExpanded(target)
+	if err != nil && (target.Optional || !install) && descriptor.IsCommandMissing(err) {
+		fmt.Fprintf(cmd.OutOrStdout(), "Skipping %s target %s: %v\n", skippedKind(install), target.Cmd, err)
+		return nil, nil
+	}
+	return descs, err
+}
+
+// skippedKind describes why a target, whose command is missing, is skipped.
+func skippedKind(install bool) string {
+	if install {
+		return "optional"
+	}
+	return "missing"
+}
+
+func targetDescriptorByConfigFile(cmd *cobra.Command, configPaths []string, configFormat string, profiles []string, tags []string, install bool) ([]*impostordatav1.TargetDescriptor, error) {
+	format := config.Format("")
+	if configFormat != "" {
+		f, err := config.ParseFormat(configFormat)
+		if err != nil {
+			return nil, fmt.Errorf("parsing 'config-format' flag value: %w", err)
+		}
+		format = f
+	}
+	loaded, err := config.Load(configPaths, format)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := loaded.Select(profiles, tags)
+	if err != nil {
+		return nil, err
+	}
+	descs := make([]*impostordatav1.TargetDescriptor, 0, len(targets))
+	locations := map[string]config.Location{}
+	errs := []error(nil)
+	for _, t := range targets {
+		if install && t.SkipReason != "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "Skipping target %s from %s: condition not met: %s\n", t.Target.Cmd, t.Location, t.SkipReason)
+			continue
+		}
+		if t.ExpandErr != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Skipping target %s from %s: %v (condition not met: %s)\n", t.Target.Cmd, t.Location, t.ExpandErr, t.SkipReason)
+			continue
+		}
+		expanded, err := descriptor.FromTargetExpanded(t.Target)
+		if err != nil && (t.Target.Optional || !install) && descriptor.IsCommandMissing(err) {
+			fmt.Fprintf(cmd.OutOrStdout(), "Skipping %s target %s from %s: %v\n", skippedKind(install), t.Target.Cmd, t.Location, err)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", t.Location, t.Target.Cmd, err)
+		}
+		for _, desc := range expanded {
+			if loc, ok := locations[desc.OriginalCmd]; ok { // different targets (for example a glob pattern and a plain command) may expand to the same command
+				if install {
+					errs = append(errs, config.ErrorDuplicateTarget{Cmd: desc.OriginalCmd, First: loc, Second: t.Location})
+				} // otherwise, alternative definitions guarded by mutually exclusive conditions simply refer to the same impostor
+				continue
+			}
+			locations[desc.OriginalCmd] = t.Location
+			desc.Provenance.ConfigPath = t.Location.Path
+			descs = append(descs, desc)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return descs, nil
+}
+
+// targetName returns name of the given target for reporting, that includes the pattern the target has been expanded from, if any.
+func targetName(t *impostordatav1.TargetDescriptor) string {
+	if p := t.GetProvenance().GetCmdPattern(); p != "" {
+		return fmt.Sprintf("%s (matched by %s)", t.OriginalCmd, p)
+	}
+	return t.OriginalCmd
+}
+
+func targetDescriptorByCmdArgs(ctx context.Context, args []string) ([]*impostordatav1.TargetDescriptor, error) {
+	descs := make([]*impostordatav1.TargetDescriptor, 0, len(args))
+	for _, a := range args {
+		desc, err := descriptor.FromTarget(&configv2.Target{Cmd: a})
+		if err != nil {
+			return nil, err
+		}
+		descs = append(descs, desc)
+	}
+	return descs, nil
+}
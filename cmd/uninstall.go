@@ -4,19 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/spf13/cobra"
 
-	configv1 "github.com/daishe/impostorcmd/config/v1"
+	configv2 "github.com/daishe/impostorcmd/config/v2"
 	"github.com/daishe/impostorcmd/internal/action"
 	"github.com/daishe/impostorcmd/internal/descriptor"
 	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
 )
 
 type uninstallOptions struct {
-	json   string
-	config string
+	targets targetsOptions
+	force   bool
+	lock    action.LockOptions
 }
 
 func uninstallCmd(r *rootOptions) *cobra.Command {
@@ -26,8 +26,9 @@ func uninstallCmd(r *rootOptions) *cobra.Command {
 		Short: "undo impostoring scheme",
 		Long:  "Stop impostoring command or commands.",
 	}
-	cmd.Flags().StringVar(&o.json, "json", "", "JSON setup description for single target")
-	cmd.Flags().StringVar(&o.config, "config", "", "JSON configuration file containing setup description")
+	o.targets.addFlags(cmd)
+	cmd.Flags().BoolVar(&o.force, "force", false, "restore original command even if it does not match fingerprint recorded during install")
+	addLockFlags(cmd, &o.lock)
 	cmd.Run = func(cmd *cobra.Command, args []string) {
 		checkErr(cmd, uninstallCmdRun(cmd, r, o, args))
 	}
@@ -35,73 +36,44 @@ func uninstallCmd(r *rootOptions) *cobra.Command {
 }
 
 func uninstallCmdRun(cmd *cobra.Command, r *rootOptions, o *uninstallOptions, args []string) (err error) {
-	isByInlineJson, isByConfig, isByArgs := o.json != "", o.config != "", len(args) > 0
-	trueCount := func(x ...bool) (count int) {
-		for _, v := range x {
-			if v {
-				count++
-			}
-		}
-		return count
-	}
 
-	switch {
-	case trueCount(isByArgs, isByInlineJson, isByConfig) == 0:
-		return fmt.Errorf("no arguments, 'json' flag nor 'config' flag specified")
-	case trueCount(isByArgs, isByInlineJson, isByConfig) == 2:
-		l := make([]string, 0, 2)
-		if isByArgs {
-			l = append(l, "arguments")
-		}
-		if isByInlineJson {
-			l = append(l, "'json' flag")
-		}
-		if isByConfig {
-			l = append(l, "'config' flag")
-		}
-		return fmt.Errorf("%s specified together", strings.Join(l, " and "))
-	case trueCount(isByArgs, isByInlineJson, isByConfig) == 3:
-		return fmt.Errorf("arguments, 'json' flag and 'config' flag specified together")
+	targetDescs, err := o.targets.targetDescriptors(cmd, args, func(ctx context.Context, args []string) ([]*impostordatav1.TargetDescriptor, error) {
+		return targetDescriptorByUninstallArgs(ctx, r, o, args)
+	})
+	if err != nil {
+		return err
 	}
 
-	targetDescs := []*impostordatav1.TargetDescriptor(nil)
-	switch {
-	case isByInlineJson:
-		targetDescs, err = targetDescriptorByJsonTarget(cmd.Context(), o.json)
-	case isByConfig:
-		targetDescs, err = targetDescriptorByConfigFile(cmd.Context(), o.config)
-	default: // isByArgs
-		targetDescs, err = targetDescriptorByUninstallArgs(cmd.Context(), r, o, args)
-	}
+	unlock, err := lockTargets(cmd, o.lock, targetDescs)
 	if err != nil {
 		return err
 	}
+	defer func() { showErr(cmd, unlock()) }()
 
-	for _, t := range targetDescs {
-		undo, err := action.Uninstall(t.OriginalCmd)
+	tx := &action.Transaction{}
+	for i, t := range targetDescs {
+		targetTx, err := action.Uninstall(t.OriginalCmd, action.UninstallOptions{Force: o.force})
+		if err != nil && errors.As(err, &descriptor.ErrorNoDescriptor{}) {
+			fmt.Fprintf(cmd.OutOrStdout(), "Skipping non impostor target %s\n", targetName(t))
+			showErr(cmd, targetTx.Rollback().Err()) // nothing has been changed, but resources held by the transaction must be released
+			continue
+		}
+		tx.Include(t.OriginalCmd, targetTx)
 		if err != nil {
-			if errors.As(err, &descriptor.ErrorNoDescriptor{}) {
-				fmt.Fprintf(cmd.OutOrStdout(), "Skipping non impostor target %s\n", t.OriginalCmd)
-				continue
-			}
 			showErr(cmd, fmt.Errorf("uninstalling target %s failed: %w", t.OriginalCmd, err))
-			if err := undo.Run(); err != nil {
-				showErr(cmd, fmt.Errorf("undoing actions taken for target %s failed: %w", t.OriginalCmd, err))
-			} else {
-				fmt.Fprintf(cmd.OutOrStdout(), "Undone actions taken for target %s\n", t.OriginalCmd)
-			}
+			rollbackTargets(cmd, tx, targetDescs[i+1:])
 			return fmt.Errorf("failure occurred while attempting to uninstall impostor in target %s", t.OriginalCmd)
 		}
-		fmt.Fprintf(cmd.OutOrStdout(), "Uninstalled impostor for target %s\n", t.OriginalCmd)
+		fmt.Fprintf(cmd.OutOrStdout(), "Uninstalled impostor for target %s\n", targetName(t))
 	}
-	return nil
+	return commitTargets(cmd, tx)
 }
 
 func targetDescriptorByUninstallArgs(ctx context.Context, r *rootOptions, o *uninstallOptions, args []string) ([]*impostordatav1.TargetDescriptor, error) {
 	if len(args) < 1 {
 		return nil, fmt.Errorf("too few arguments provided: missing target-command")
 	}
-	target := &configv1.Target{Cmd: args[0]}
+	target := &configv2.Target{Cmd: args[0]}
 	desc, err := descriptor.FromTarget(target)
 	if err != nil {
 		return nil, err
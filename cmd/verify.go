@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/daishe/impostorcmd/internal/action"
+	"github.com/daishe/impostorcmd/internal/descriptor"
+)
+
+type verifyOptions struct {
+	targets targetsOptions
+}
+
+func verifyCmd(r *rootOptions) *cobra.Command {
+	o := &verifyOptions{}
+	cmd := &cobra.Command{
+		Use:   "verify [option]... target-command...",
+		Short: "verify impostored commands",
+		Long:  "Verify that original commands of impostors match fingerprints recorded during install.",
+	}
+	o.targets.addFlags(cmd)
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		checkErr(cmd, verifyCmdRun(cmd, r, o, args))
+	}
+	return cmd
+}
+
+func verifyCmdRun(cmd *cobra.Command, r *rootOptions, o *verifyOptions, args []string) (err error) {
+
+	targetDescs, err := o.targets.targetDescriptors(cmd, args, targetDescriptorByCmdArgs)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, t := range targetDescs {
+		desc, err := action.Describe(t.OriginalCmd)
+		if err != nil {
+			if errors.As(err, &descriptor.ErrorNoDescriptor{}) {
+				fmt.Fprintf(cmd.OutOrStdout(), "Skipping non impostor target %s\n", targetName(t))
+				continue
+			}
+			failed++
+			showErr(cmd, fmt.Errorf("inspecting target %s failed: %w", t.OriginalCmd, err))
+			continue
+		}
+		if err := action.VerifyOriginal(desc); err != nil {
+			if errors.As(err, &descriptor.ErrorNoFingerprint{}) {
+				fmt.Fprintf(cmd.OutOrStdout(), "Unable to verify target %s: original command fingerprint has not been recorded\n", targetName(t))
+				continue
+			}
+			failed++
+			showErr(cmd, fmt.Errorf("verifying target %s failed: %w", t.OriginalCmd, err))
+			continue
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Verified target %s\n", targetName(t))
+	}
+	if failed > 0 {
+		return fmt.Errorf("verification of %d target(s) failed", failed)
+	}
+	return nil
+}
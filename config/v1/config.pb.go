@@ -20,6 +20,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SignaturePolicy int32
+
+const (
+	SignaturePolicy_SIGNATURE_POLICY_UNSPECIFIED SignaturePolicy = 0
+	SignaturePolicy_SIGNATURE_POLICY_ALLOW       SignaturePolicy = 1 // run impostor anyway
+	SignaturePolicy_SIGNATURE_POLICY_WARN        SignaturePolicy = 2 // run impostor, but print a warning
+	SignaturePolicy_SIGNATURE_POLICY_DENY        SignaturePolicy = 3 // refuse to run
+)
+
+// Enum value maps for SignaturePolicy.
+var (
+	SignaturePolicy_name = map[int32]string{
+		0: "SIGNATURE_POLICY_UNSPECIFIED",
+		1: "SIGNATURE_POLICY_ALLOW",
+		2: "SIGNATURE_POLICY_WARN",
+		3: "SIGNATURE_POLICY_DENY",
+	}
+	SignaturePolicy_value = map[string]int32{
+		"SIGNATURE_POLICY_UNSPECIFIED": 0,
+		"SIGNATURE_POLICY_ALLOW":       1,
+		"SIGNATURE_POLICY_WARN":        2,
+		"SIGNATURE_POLICY_DENY":        3,
+	}
+)
+
+func (x SignaturePolicy) Enum() *SignaturePolicy {
+	p := new(SignaturePolicy)
+	*p = x
+	return p
+}
+
+func (x SignaturePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignaturePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_v1_config_proto_enumTypes[0].Descriptor()
+}
+
+func (SignaturePolicy) Type() protoreflect.EnumType {
+	return &file_config_v1_config_proto_enumTypes[0]
+}
+
+func (x SignaturePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignaturePolicy.Descriptor instead.
+func (SignaturePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{0}
+}
+
 type VersionEntity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -72,8 +124,11 @@ type Config struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version string    `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"` // for this object must equal to "v1" when used as root object
-	Targets []*Target `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"` // list of targets
+	Version  string              `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                                                                                           // for this object must equal to "v1" when used as root object
+	Targets  []*Target           `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`                                                                                           // list of targets
+	Includes []string            `protobuf:"bytes,3,rep,name=includes,proto3" json:"includes,omitempty"`                                                                                         // paths or glob patterns (relative to the including file) of additional configuration files or directories with configuration fragments to merge in
+	Vars     map[string]string   `protobuf:"bytes,4,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`         // user-defined variables available (as ${name}) in cmd, impostor and impostor_args of targets of this file and files it includes (values may refer to ${HOME}, ${config_dir} and ${env:VAR}, but not to other user-defined variables)
+	Profiles map[string]*Profile `protobuf:"bytes,5,rep,name=profiles,proto3" json:"profiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // named sets of targets (profiles with the same name defined in different files are merged)
 }
 
 func (x *Config) Reset() {
@@ -122,16 +177,44 @@ func (x *Config) GetTargets() []*Target {
 	return nil
 }
 
+func (x *Config) GetIncludes() []string {
+	if x != nil {
+		return x.Includes
+	}
+	return nil
+}
+
+func (x *Config) GetVars() map[string]string {
+	if x != nil {
+		return x.Vars
+	}
+	return nil
+}
+
+func (x *Config) GetProfiles() map[string]*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
 type Target struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version      string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                               // for this object must equal to "v1" when used as root object
-	Cmd          string   `protobuf:"bytes,2,opt,name=cmd,proto3" json:"cmd,omitempty"`                                       // command to impostor
-	Impostor     string   `protobuf:"bytes,3,opt,name=impostor,proto3" json:"impostor,omitempty"`                             // impostor command
-	ImpostorArgs []string `protobuf:"bytes,4,rep,name=impostor_args,json=impostorArgs,proto3" json:"impostor_args,omitempty"` // additional impostor command arguments
-	IncludeArg_0 bool     `protobuf:"varint,5,opt,name=include_arg_0,json=includeArg0,proto3" json:"include_arg_0,omitempty"` // whether to append (before arg 1) arg 0 from the original command (note it will result in an additional argument: <impostor arg 0> <impostor arg 1> ... <impostor arg n> <original arg 0> <arg 1> ... <arg n>)
+	Version        string     `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                                      // for this object must equal to "v1" when used as root object
+	Cmd            string     `protobuf:"bytes,2,opt,name=cmd,proto3" json:"cmd,omitempty"`                                              // command to impostor (may be a glob pattern, in which case every matching command is impostored)
+	Impostor       string     `protobuf:"bytes,3,opt,name=impostor,proto3" json:"impostor,omitempty"`                                    // impostor command
+	ImpostorArgs   []string   `protobuf:"bytes,4,rep,name=impostor_args,json=impostorArgs,proto3" json:"impostor_args,omitempty"`        // additional impostor command arguments
+	IncludeArg_0   bool       `protobuf:"varint,5,opt,name=include_arg_0,json=includeArg0,proto3" json:"include_arg_0,omitempty"`        // whether to append (before arg 1) arg 0 from the original command (note it will result in an additional argument: <impostor arg 0> <impostor arg 1> ... <impostor arg n> <original arg 0> <arg 1> ... <arg n>)
+	VerifyOriginal bool       `protobuf:"varint,6,opt,name=verify_original,json=verifyOriginal,proto3" json:"verify_original,omitempty"` // whether to verify original command against fingerprint captured during install on every impostor invocation
+	Description    string     `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`                              // free-form description, why the command is impostored
+	Owner          string     `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`                                          // free-form owner (person, team, etc.) responsible for the impostor
+	AllInPath      bool       `protobuf:"varint,9,opt,name=all_in_path,json=allInPath,proto3" json:"all_in_path,omitempty"`              // whether to impostor every command with the given name (or matching the given glob pattern) found along PATH, instead of the first one only (cmd must not be a path then)
+	Optional       bool       `protobuf:"varint,10,opt,name=optional,proto3" json:"optional,omitempty"`                                  // whether to skip the target (with a notice), instead of failing, when the command does not exist
+	When           *Condition `protobuf:"bytes,11,opt,name=when,proto3" json:"when,omitempty"`                                           // conditions that must all be met for the target to be used (the target is skipped with a notice otherwise)
+	Tags           []string   `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`                                           // free-form tags used to select groups of targets
 }
 
 func (x *Target) Reset() {
@@ -201,6 +284,347 @@ func (x *Target) GetIncludeArg_0() bool {
 	return false
 }
 
+func (x *Target) GetVerifyOriginal() bool {
+	if x != nil {
+		return x.VerifyOriginal
+	}
+	return false
+}
+
+func (x *Target) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Target) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Target) GetAllInPath() bool {
+	if x != nil {
+		return x.AllInPath
+	}
+	return false
+}
+
+func (x *Target) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *Target) GetWhen() *Condition {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *Target) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags        []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`               // targets with any of the given tags belong to the profile
+	Cmds        []string `protobuf:"bytes,2,rep,name=cmds,proto3" json:"cmds,omitempty"`               // targets with any of the given commands (as written in configuration, after variable expansion) belong to the profile
+	Description string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // free-form description of the profile
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v1_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Profile) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Profile) GetCmds() []string {
+	if x != nil {
+		return x.Cmds
+	}
+	return nil
+}
+
+func (x *Profile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type Condition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommandExists []string `protobuf:"bytes,1,rep,name=command_exists,json=commandExists,proto3" json:"command_exists,omitempty"` // commands (names looked up along PATH or paths) that must all exist
+	FileExists    []string `protobuf:"bytes,2,rep,name=file_exists,json=fileExists,proto3" json:"file_exists,omitempty"`          // paths of files or directories that must all exist
+	Hostname      []string `protobuf:"bytes,3,rep,name=hostname,proto3" json:"hostname,omitempty"`                                // glob patterns, one of which must match the hostname (any hostname, when empty)
+	Arch          []string `protobuf:"bytes,4,rep,name=arch,proto3" json:"arch,omitempty"`                                        // architectures (as in GOARCH, for example amd64 or arm64), one of which must match the current one (any architecture, when empty)
+	EnvSet        []string `protobuf:"bytes,5,rep,name=env_set,json=envSet,proto3" json:"env_set,omitempty"`                      // names of environment variables that must all be set
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v1_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Condition) GetCommandExists() []string {
+	if x != nil {
+		return x.CommandExists
+	}
+	return nil
+}
+
+func (x *Condition) GetFileExists() []string {
+	if x != nil {
+		return x.FileExists
+	}
+	return nil
+}
+
+func (x *Condition) GetHostname() []string {
+	if x != nil {
+		return x.Hostname
+	}
+	return nil
+}
+
+func (x *Condition) GetArch() []string {
+	if x != nil {
+		return x.Arch
+	}
+	return nil
+}
+
+func (x *Condition) GetEnvSet() []string {
+	if x != nil {
+		return x.EnvSet
+	}
+	return nil
+}
+
+type Trust struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version          string          `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                                                                                       // must equal to "v1"
+	TrustedKeys      []string        `protobuf:"bytes,2,rep,name=trusted_keys,json=trustedKeys,proto3" json:"trusted_keys,omitempty"`                                                            // ed25519 public keys trusted to sign impostor descriptors (base64 encoded raw keys or PEM encoded PKIX keys)
+	MissingSignature SignaturePolicy `protobuf:"varint,3,opt,name=missing_signature,json=missingSignature,proto3,enum=impostorcmd.config.v1.SignaturePolicy" json:"missing_signature,omitempty"` // what to do when impostor descriptor is not signed (deny when unspecified)
+	InvalidSignature SignaturePolicy `protobuf:"varint,4,opt,name=invalid_signature,json=invalidSignature,proto3,enum=impostorcmd.config.v1.SignaturePolicy" json:"invalid_signature,omitempty"` // what to do when impostor descriptor signature is invalid or made with untrusted key (deny when unspecified)
+}
+
+func (x *Trust) Reset() {
+	*x = Trust{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v1_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trust) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trust) ProtoMessage() {}
+
+func (x *Trust) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trust.ProtoReflect.Descriptor instead.
+func (*Trust) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Trust) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Trust) GetTrustedKeys() []string {
+	if x != nil {
+		return x.TrustedKeys
+	}
+	return nil
+}
+
+func (x *Trust) GetMissingSignature() SignaturePolicy {
+	if x != nil {
+		return x.MissingSignature
+	}
+	return SignaturePolicy_SIGNATURE_POLICY_UNSPECIFIED
+}
+
+func (x *Trust) GetInvalidSignature() SignaturePolicy {
+	if x != nil {
+		return x.InvalidSignature
+	}
+	return SignaturePolicy_SIGNATURE_POLICY_UNSPECIFIED
+}
+
+type Policy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version            string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                                                    // must equal to "v1"
+	AllowedTargets     []string `protobuf:"bytes,2,rep,name=allowed_targets,json=allowedTargets,proto3" json:"allowed_targets,omitempty"`                // glob patterns of target command paths that may be impostored (any, when empty)
+	DeniedTargets      []string `protobuf:"bytes,3,rep,name=denied_targets,json=deniedTargets,proto3" json:"denied_targets,omitempty"`                   // glob patterns of target command paths that must not be impostored (takes precedence over allowed targets)
+	AllowedImpostors   []string `protobuf:"bytes,4,rep,name=allowed_impostors,json=allowedImpostors,proto3" json:"allowed_impostors,omitempty"`          // glob patterns of impostor command paths that may be used (any, when empty)
+	AllowSetuidTargets bool     `protobuf:"varint,5,opt,name=allow_setuid_targets,json=allowSetuidTargets,proto3" json:"allow_setuid_targets,omitempty"` // whether commands with setuid or setgid bit may be impostored
+}
+
+func (x *Policy) Reset() {
+	*x = Policy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v1_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policy) ProtoMessage() {}
+
+func (x *Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
+func (*Policy) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Policy) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Policy) GetAllowedTargets() []string {
+	if x != nil {
+		return x.AllowedTargets
+	}
+	return nil
+}
+
+func (x *Policy) GetDeniedTargets() []string {
+	if x != nil {
+		return x.DeniedTargets
+	}
+	return nil
+}
+
+func (x *Policy) GetAllowedImpostors() []string {
+	if x != nil {
+		return x.AllowedImpostors
+	}
+	return nil
+}
+
+func (x *Policy) GetAllowSetuidTargets() bool {
+	if x != nil {
+		return x.AllowSetuidTargets
+	}
+	return false
+}
+
 var File_config_v1_config_proto protoreflect.FileDescriptor
 
 var file_config_v1_config_proto_rawDesc = []byte{
@@ -209,36 +633,122 @@ var file_config_v1_config_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x22,
 	0x29, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
 	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x06, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37,
-	0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x6d, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x6d, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6d,
-	0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x41, 0x72, 0x67, 0x73, 0x12,
-	0x22, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x5f, 0x30,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41,
-	0x72, 0x67, 0x30, 0x42, 0xd0, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6d, 0x70, 0x6f,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x03, 0x0a, 0x06, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x37, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x76, 0x61, 0x72,
+	0x73, 0x12, 0x47, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d,
+	0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x56, 0x61,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72,
+	0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x80, 0x03, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x6d, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x5f,
+	0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x6f,
+	0x73, 0x74, 0x6f, 0x72, 0x41, 0x72, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x5f, 0x30, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x72, 0x67, 0x30, 0x12, 0x27, 0x0a, 0x0f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0b, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x49, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x77, 0x68, 0x65,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x22, 0x53, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6d, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6d, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
+	0x63, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68, 0x12, 0x17,
+	0x0a, 0x07, 0x65, 0x6e, 0x76, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x6e, 0x76, 0x53, 0x65, 0x74, 0x22, 0xee, 0x01, 0x0a, 0x05, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x53,
+	0x0a, 0x11, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x69, 0x6d, 0x70, 0x6f,
 	0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76,
-	0x31, 0x42, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x69,
-	0x73, 0x68, 0x65, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x43, 0x58, 0xaa, 0x02, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x73,
-	0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x5c, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x49, 0x6d, 0x70, 0x6f, 0x73,
-	0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x5c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x49,
-	0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x53, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26,
+	0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x10, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x06, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a,
+	0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64,
+	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x74, 0x75, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53,
+	0x65, 0x74, 0x75, 0x69, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x2a, 0x85, 0x01, 0x0a,
+	0x0f, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x20, 0x0a, 0x1c, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x19,
+	0x0a, 0x15, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x49, 0x47,
+	0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x44, 0x45,
+	0x4e, 0x59, 0x10, 0x03, 0x42, 0xd0, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6d, 0x70,
+	0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x76, 0x31, 0x42, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
+	0x69, 0x73, 0x68, 0x65, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x43, 0x58, 0xaa, 0x02, 0x15, 0x49, 0x6d, 0x70, 0x6f,
+	0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x5c,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x49, 0x6d, 0x70, 0x6f,
+	0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x5c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17,
+	0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x3a, 0x3a, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -253,19 +763,33 @@ func file_config_v1_config_proto_rawDescGZIP() []byte {
 	return file_config_v1_config_proto_rawDescData
 }
 
-var file_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_config_v1_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_config_v1_config_proto_goTypes = []interface{}{
-	(*VersionEntity)(nil), // 0: impostorcmd.config.v1.VersionEntity
-	(*Config)(nil),        // 1: impostorcmd.config.v1.Config
-	(*Target)(nil),        // 2: impostorcmd.config.v1.Target
+	(SignaturePolicy)(0),  // 0: impostorcmd.config.v1.SignaturePolicy
+	(*VersionEntity)(nil), // 1: impostorcmd.config.v1.VersionEntity
+	(*Config)(nil),        // 2: impostorcmd.config.v1.Config
+	(*Target)(nil),        // 3: impostorcmd.config.v1.Target
+	(*Profile)(nil),       // 4: impostorcmd.config.v1.Profile
+	(*Condition)(nil),     // 5: impostorcmd.config.v1.Condition
+	(*Trust)(nil),         // 6: impostorcmd.config.v1.Trust
+	(*Policy)(nil),        // 7: impostorcmd.config.v1.Policy
+	nil,                   // 8: impostorcmd.config.v1.Config.VarsEntry
+	nil,                   // 9: impostorcmd.config.v1.Config.ProfilesEntry
 }
 var file_config_v1_config_proto_depIdxs = []int32{
-	2, // 0: impostorcmd.config.v1.Config.targets:type_name -> impostorcmd.config.v1.Target
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: impostorcmd.config.v1.Config.targets:type_name -> impostorcmd.config.v1.Target
+	8, // 1: impostorcmd.config.v1.Config.vars:type_name -> impostorcmd.config.v1.Config.VarsEntry
+	9, // 2: impostorcmd.config.v1.Config.profiles:type_name -> impostorcmd.config.v1.Config.ProfilesEntry
+	5, // 3: impostorcmd.config.v1.Target.when:type_name -> impostorcmd.config.v1.Condition
+	0, // 4: impostorcmd.config.v1.Trust.missing_signature:type_name -> impostorcmd.config.v1.SignaturePolicy
+	0, // 5: impostorcmd.config.v1.Trust.invalid_signature:type_name -> impostorcmd.config.v1.SignaturePolicy
+	4, // 6: impostorcmd.config.v1.Config.ProfilesEntry.value:type_name -> impostorcmd.config.v1.Profile
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_config_v1_config_proto_init() }
@@ -310,19 +834,68 @@ func file_config_v1_config_proto_init() {
 				return nil
 			}
 		}
+		file_config_v1_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v1_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Condition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v1_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trust); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v1_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Policy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_v1_config_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_config_v1_config_proto_goTypes,
 		DependencyIndexes: file_config_v1_config_proto_depIdxs,
+		EnumInfos:         file_config_v1_config_proto_enumTypes,
 		MessageInfos:      file_config_v1_config_proto_msgTypes,
 	}.Build()
 	File_config_v1_config_proto = out.File
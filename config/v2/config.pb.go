@@ -0,0 +1,1809 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        (unknown)
+// source: config/v2/config.proto
+
+package configv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VersionEntity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *VersionEntity) Reset() {
+	*x = VersionEntity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionEntity) ProtoMessage() {}
+
+func (x *VersionEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionEntity.ProtoReflect.Descriptor instead.
+func (*VersionEntity) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VersionEntity) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version  string              `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                                                                                           // for this object must equal to "v2" when used as root object
+	Targets  []*Target           `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`                                                                                           // list of targets
+	Includes []string            `protobuf:"bytes,3,rep,name=includes,proto3" json:"includes,omitempty"`                                                                                         // paths or glob patterns (relative to the including file) of additional configuration files or directories with configuration fragments to merge in
+	Vars     map[string]string   `protobuf:"bytes,4,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`         // user-defined variables available (as ${name}) in cmd and handler command, arguments and interpreter of targets of this file and files it includes (values may refer to ${HOME}, ${config_dir} and ${env:VAR}, but not to other user-defined variables)
+	Profiles map[string]*Profile `protobuf:"bytes,5,rep,name=profiles,proto3" json:"profiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // named sets of targets (profiles with the same name defined in different files are merged)
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Config) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Config) GetTargets() []*Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *Config) GetIncludes() []string {
+	if x != nil {
+		return x.Includes
+	}
+	return nil
+}
+
+func (x *Config) GetVars() map[string]string {
+	if x != nil {
+		return x.Vars
+	}
+	return nil
+}
+
+func (x *Config) GetProfiles() map[string]*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type Target struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version     string          `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                         // for this object must equal to "v2" when used as root object
+	Cmd         string          `protobuf:"bytes,2,opt,name=cmd,proto3" json:"cmd,omitempty"`                                 // command to impostor (may be a glob pattern, in which case every matching command is impostored)
+	Handler     *Handler        `protobuf:"bytes,3,opt,name=handler,proto3" json:"handler,omitempty"`                         // what to run instead of the command
+	Runtime     *RuntimeOptions `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`                         // options applied on every impostor invocation
+	AllInPath   bool            `protobuf:"varint,5,opt,name=all_in_path,json=allInPath,proto3" json:"all_in_path,omitempty"` // whether to impostor every command with the given name (or matching the given glob pattern) found along PATH, instead of the first one only (cmd must not be a path then)
+	Optional    bool            `protobuf:"varint,6,opt,name=optional,proto3" json:"optional,omitempty"`                      // whether to skip the target (with a notice), instead of failing, when the command does not exist
+	When        *Condition      `protobuf:"bytes,7,opt,name=when,proto3" json:"when,omitempty"`                               // conditions that must all be met for the target to be used (the target is skipped with a notice otherwise)
+	Tags        []string        `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                               // free-form tags used to select groups of targets
+	Description string          `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`                 // free-form description, why the command is impostored
+	Owner       string          `protobuf:"bytes,10,opt,name=owner,proto3" json:"owner,omitempty"`                            // free-form owner (person, team, etc.) responsible for the impostor
+	Rules       []*Rule         `protobuf:"bytes,11,rep,name=rules,proto3" json:"rules,omitempty"`                            // rules evaluated in order on every invocation, the first one that matches selects the handler (handler of the target is used, when none matches)
+}
+
+func (x *Target) Reset() {
+	*x = Target{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Target) ProtoMessage() {}
+
+func (x *Target) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Target.ProtoReflect.Descriptor instead.
+func (*Target) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Target) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Target) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *Target) GetHandler() *Handler {
+	if x != nil {
+		return x.Handler
+	}
+	return nil
+}
+
+func (x *Target) GetRuntime() *RuntimeOptions {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
+func (x *Target) GetAllInPath() bool {
+	if x != nil {
+		return x.AllInPath
+	}
+	return false
+}
+
+func (x *Target) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *Target) GetWhen() *Condition {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *Target) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Target) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Target) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Target) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type Handler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//	*Handler_External
+	//	*Handler_Builtin
+	//	*Handler_Script
+	Kind isHandler_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *Handler) Reset() {
+	*x = Handler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Handler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Handler) ProtoMessage() {}
+
+func (x *Handler) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Handler.ProtoReflect.Descriptor instead.
+func (*Handler) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *Handler) GetKind() isHandler_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Handler) GetExternal() *ExternalHandler {
+	if x, ok := x.GetKind().(*Handler_External); ok {
+		return x.External
+	}
+	return nil
+}
+
+func (x *Handler) GetBuiltin() *BuiltinHandler {
+	if x, ok := x.GetKind().(*Handler_Builtin); ok {
+		return x.Builtin
+	}
+	return nil
+}
+
+func (x *Handler) GetScript() *ScriptHandler {
+	if x, ok := x.GetKind().(*Handler_Script); ok {
+		return x.Script
+	}
+	return nil
+}
+
+type isHandler_Kind interface {
+	isHandler_Kind()
+}
+
+type Handler_External struct {
+	External *ExternalHandler `protobuf:"bytes,1,opt,name=external,proto3,oneof"` // run an external command
+}
+
+type Handler_Builtin struct {
+	Builtin *BuiltinHandler `protobuf:"bytes,2,opt,name=builtin,proto3,oneof"` // use handler built into impostorcmd
+}
+
+type Handler_Script struct {
+	Script *ScriptHandler `protobuf:"bytes,3,opt,name=script,proto3,oneof"` // run an inline script
+}
+
+func (*Handler_External) isHandler_Kind() {}
+
+func (*Handler_Builtin) isHandler_Kind() {}
+
+func (*Handler_Script) isHandler_Kind() {}
+
+type ExternalHandler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cmd          string   `protobuf:"bytes,1,opt,name=cmd,proto3" json:"cmd,omitempty"`                                       // impostor command
+	Args         []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`                                     // additional impostor command arguments (passed before arguments of the original command)
+	ArgsTemplate []string `protobuf:"bytes,3,rep,name=args_template,json=argsTemplate,proto3" json:"args_template,omitempty"` // impostor command arguments with placeholders ({args}, {arg0}, {arg:N}, {original_cmd}, {target_name}, {cwd}, {{ for a literal brace), used instead of args, so that arguments of the original command are passed only where placeholders put them (include_arg_0 has no effect then)
+}
+
+func (x *ExternalHandler) Reset() {
+	*x = ExternalHandler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalHandler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalHandler) ProtoMessage() {}
+
+func (x *ExternalHandler) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalHandler.ProtoReflect.Descriptor instead.
+func (*ExternalHandler) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExternalHandler) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *ExternalHandler) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ExternalHandler) GetArgsTemplate() []string {
+	if x != nil {
+		return x.ArgsTemplate
+	}
+	return nil
+}
+
+type BuiltinHandler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                               // name of the builtin handler: "passthrough" (run the original command unchanged), "deny" (refuse to run, printing message option, exiting with exit_code option or 126) or "augment" (run the original command with arguments rewritten by rewrites)
+	Options  map[string]string  `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // handler specific options
+	Rewrites []*ArgumentRewrite `protobuf:"bytes,3,rep,name=rewrites,proto3" json:"rewrites,omitempty"`                                                                                       // argument rewrites applied in order by the augment handler
+}
+
+func (x *BuiltinHandler) Reset() {
+	*x = BuiltinHandler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinHandler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinHandler) ProtoMessage() {}
+
+func (x *BuiltinHandler) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinHandler.ProtoReflect.Descriptor instead.
+func (*BuiltinHandler) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BuiltinHandler) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuiltinHandler) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *BuiltinHandler) GetRewrites() []*ArgumentRewrite {
+	if x != nil {
+		return x.Rewrites
+	}
+	return nil
+}
+
+type ArgumentRewrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//	*ArgumentRewrite_Insert
+	//	*ArgumentRewrite_Drop
+	//	*ArgumentRewrite_Replace
+	//	*ArgumentRewrite_RemapSubcommand
+	Kind isArgumentRewrite_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *ArgumentRewrite) Reset() {
+	*x = ArgumentRewrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArgumentRewrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArgumentRewrite) ProtoMessage() {}
+
+func (x *ArgumentRewrite) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArgumentRewrite.ProtoReflect.Descriptor instead.
+func (*ArgumentRewrite) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *ArgumentRewrite) GetKind() isArgumentRewrite_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *ArgumentRewrite) GetInsert() *InsertArgs {
+	if x, ok := x.GetKind().(*ArgumentRewrite_Insert); ok {
+		return x.Insert
+	}
+	return nil
+}
+
+func (x *ArgumentRewrite) GetDrop() *DropArgs {
+	if x, ok := x.GetKind().(*ArgumentRewrite_Drop); ok {
+		return x.Drop
+	}
+	return nil
+}
+
+func (x *ArgumentRewrite) GetReplace() *ReplaceArgs {
+	if x, ok := x.GetKind().(*ArgumentRewrite_Replace); ok {
+		return x.Replace
+	}
+	return nil
+}
+
+func (x *ArgumentRewrite) GetRemapSubcommand() *RemapSubcommand {
+	if x, ok := x.GetKind().(*ArgumentRewrite_RemapSubcommand); ok {
+		return x.RemapSubcommand
+	}
+	return nil
+}
+
+type isArgumentRewrite_Kind interface {
+	isArgumentRewrite_Kind()
+}
+
+type ArgumentRewrite_Insert struct {
+	Insert *InsertArgs `protobuf:"bytes,1,opt,name=insert,proto3,oneof"` // insert arguments
+}
+
+type ArgumentRewrite_Drop struct {
+	Drop *DropArgs `protobuf:"bytes,2,opt,name=drop,proto3,oneof"` // drop flags
+}
+
+type ArgumentRewrite_Replace struct {
+	Replace *ReplaceArgs `protobuf:"bytes,3,opt,name=replace,proto3,oneof"` // replace regular expression matches within every argument
+}
+
+type ArgumentRewrite_RemapSubcommand struct {
+	RemapSubcommand *RemapSubcommand `protobuf:"bytes,4,opt,name=remap_subcommand,json=remapSubcommand,proto3,oneof"` // replace subcommand
+}
+
+func (*ArgumentRewrite_Insert) isArgumentRewrite_Kind() {}
+
+func (*ArgumentRewrite_Drop) isArgumentRewrite_Kind() {}
+
+func (*ArgumentRewrite_Replace) isArgumentRewrite_Kind() {}
+
+func (*ArgumentRewrite_RemapSubcommand) isArgumentRewrite_Kind() {}
+
+type InsertArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Args  []string `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`                 // arguments to insert
+	AtEnd bool     `protobuf:"varint,2,opt,name=at_end,json=atEnd,proto3" json:"at_end,omitempty"` // whether to append the arguments after all others, instead of inserting them before all others
+}
+
+func (x *InsertArgs) Reset() {
+	*x = InsertArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertArgs) ProtoMessage() {}
+
+func (x *InsertArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertArgs.ProtoReflect.Descriptor instead.
+func (*InsertArgs) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InsertArgs) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *InsertArgs) GetAtEnd() bool {
+	if x != nil {
+		return x.AtEnd
+	}
+	return false
+}
+
+type DropArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags     []string `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`                           // flags to drop, both in "--flag" and "--flag=value" form
+	WithValue bool     `protobuf:"varint,2,opt,name=with_value,json=withValue,proto3" json:"with_value,omitempty"` // whether flags take a value, so that the argument following a flag given without "=value" is dropped too
+}
+
+func (x *DropArgs) Reset() {
+	*x = DropArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropArgs) ProtoMessage() {}
+
+func (x *DropArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropArgs.ProtoReflect.Descriptor instead.
+func (*DropArgs) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DropArgs) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *DropArgs) GetWithValue() bool {
+	if x != nil {
+		return x.WithValue
+	}
+	return false
+}
+
+type ReplaceArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Regex       string `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`             // regular expression (RE2 syntax)
+	Replacement string `protobuf:"bytes,2,opt,name=replacement,proto3" json:"replacement,omitempty"` // replacement, that may refer to submatches ($1, ${name})
+}
+
+func (x *ReplaceArgs) Reset() {
+	*x = ReplaceArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceArgs) ProtoMessage() {}
+
+func (x *ReplaceArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceArgs.ProtoReflect.Descriptor instead.
+func (*ReplaceArgs) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReplaceArgs) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+func (x *ReplaceArgs) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+type RemapSubcommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // subcommand to replace (the first argument not starting with "-")
+	To   []string `protobuf:"bytes,2,rep,name=to,proto3" json:"to,omitempty"`     // arguments to replace the subcommand with (dropping it, when empty)
+}
+
+func (x *RemapSubcommand) Reset() {
+	*x = RemapSubcommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemapSubcommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemapSubcommand) ProtoMessage() {}
+
+func (x *RemapSubcommand) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemapSubcommand.ProtoReflect.Descriptor instead.
+func (*RemapSubcommand) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RemapSubcommand) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RemapSubcommand) GetTo() []string {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ScriptHandler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interpreter []string `protobuf:"bytes,1,rep,name=interpreter,proto3" json:"interpreter,omitempty"` // interpreter command and its arguments preceding the script source (["/bin/sh", "-c"] when empty); script receives the original command name as argument 0 followed by its arguments
+	Source      string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`           // script source (variables are not expanded in it)
+}
+
+func (x *ScriptHandler) Reset() {
+	*x = ScriptHandler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScriptHandler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptHandler) ProtoMessage() {}
+
+func (x *ScriptHandler) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScriptHandler.ProtoReflect.Descriptor instead.
+func (*ScriptHandler) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ScriptHandler) GetInterpreter() []string {
+	if x != nil {
+		return x.Interpreter
+	}
+	return nil
+}
+
+func (x *ScriptHandler) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type RuntimeOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncludeArg_0     bool              `protobuf:"varint,1,opt,name=include_arg_0,json=includeArg0,proto3" json:"include_arg_0,omitempty"`                                                   // whether to pass (before arg 1) arg 0 from the original command to external handler
+	VerifyOriginal   bool              `protobuf:"varint,2,opt,name=verify_original,json=verifyOriginal,proto3" json:"verify_original,omitempty"`                                            // whether to verify original command against fingerprint captured during install on every impostor invocation (the whole command is hashed only when its identity or metadata have changed since install)
+	Env              map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // environment variables set for the handler
+	DeclineExitCode  uint32            `protobuf:"varint,4,opt,name=decline_exit_code,json=declineExitCode,proto3" json:"decline_exit_code,omitempty"`                                       // when non-zero, handler exiting with this code declines the invocation and the original command is run instead, with unchanged arguments
+	DeclineControlFd bool              `protobuf:"varint,5,opt,name=decline_control_fd,json=declineControlFd,proto3" json:"decline_control_fd,omitempty"`                                    // whether to pass handler a control file descriptor (its number is in IMPOSTORCMD_CONTROL_FD environment variable), writing "decline" line to which declines the invocation regardless of the exit code (not supported on Windows)
+	Pin              bool              `protobuf:"varint,6,opt,name=pin,proto3" json:"pin,omitempty"`                                                                                        // whether to resolve impostor commands (or script interpreters) of the handler and rules during install and refuse to run them, when their path or contents change (as --pin-impostor install option does)
+}
+
+func (x *RuntimeOptions) Reset() {
+	*x = RuntimeOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeOptions) ProtoMessage() {}
+
+func (x *RuntimeOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeOptions.ProtoReflect.Descriptor instead.
+func (*RuntimeOptions) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RuntimeOptions) GetIncludeArg_0() bool {
+	if x != nil {
+		return x.IncludeArg_0
+	}
+	return false
+}
+
+func (x *RuntimeOptions) GetVerifyOriginal() bool {
+	if x != nil {
+		return x.VerifyOriginal
+	}
+	return false
+}
+
+func (x *RuntimeOptions) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *RuntimeOptions) GetDeclineExitCode() uint32 {
+	if x != nil {
+		return x.DeclineExitCode
+	}
+	return 0
+}
+
+func (x *RuntimeOptions) GetDeclineControlFd() bool {
+	if x != nil {
+		return x.DeclineControlFd
+	}
+	return false
+}
+
+func (x *RuntimeOptions) GetPin() bool {
+	if x != nil {
+		return x.Pin
+	}
+	return false
+}
+
+type Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Match   *RuleMatch `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`     // predicates that must all hold for the rule to match (the rule always matches, when empty)
+	Handler *Handler   `protobuf:"bytes,2,opt,name=handler,proto3" json:"handler,omitempty"` // what to run, when the rule matches (builtin passthrough runs the original command and builtin deny refuses to run)
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Rule) GetMatch() *RuleMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *Rule) GetHandler() *Handler {
+	if x != nil {
+		return x.Handler
+	}
+	return nil
+}
+
+type RuleMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArgsRegex string            `protobuf:"bytes,1,opt,name=args_regex,json=argsRegex,proto3" json:"args_regex,omitempty"`                                                                                         // regular expression (RE2 syntax), that must match arguments (without argument #0) joined with single spaces
+	ArgsGlob  []string          `protobuf:"bytes,2,rep,name=args_glob,json=argsGlob,proto3" json:"args_glob,omitempty"`                                                                                            // glob patterns, that must match leading arguments (without argument #0) one by one, for example ["push"] matches "docker push --all"
+	EnvSet    []string          `protobuf:"bytes,3,rep,name=env_set,json=envSet,proto3" json:"env_set,omitempty"`                                                                                                  // names of environment variables that must all be set
+	EnvEquals map[string]string `protobuf:"bytes,4,rep,name=env_equals,json=envEquals,proto3" json:"env_equals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // environment variables that must all be set to the given values
+	CwdPrefix []string          `protobuf:"bytes,5,rep,name=cwd_prefix,json=cwdPrefix,proto3" json:"cwd_prefix,omitempty"`                                                                                         // directories, one of which must contain the working directory (any directory, when empty)
+	Uid       []uint32          `protobuf:"varint,6,rep,packed,name=uid,proto3" json:"uid,omitempty"`                                                                                                              // user IDs, one of which must be the user ID of the invoking process (any user, when empty)
+	Gid       []uint32          `protobuf:"varint,7,rep,packed,name=gid,proto3" json:"gid,omitempty"`                                                                                                              // group IDs, one of which must be the group ID of the invoking process (any group, when empty)
+	Parent    []string          `protobuf:"bytes,8,rep,name=parent,proto3" json:"parent,omitempty"`                                                                                                                // glob patterns, one of which must match name of the parent process executable (any parent, when empty)
+	StdinTty  *bool             `protobuf:"varint,9,opt,name=stdin_tty,json=stdinTty,proto3,oneof" json:"stdin_tty,omitempty"`                                                                                     // whether standard input must (or must not) be a terminal (either, when unset)
+	StdoutTty *bool             `protobuf:"varint,10,opt,name=stdout_tty,json=stdoutTty,proto3,oneof" json:"stdout_tty,omitempty"`                                                                                 // whether standard output must (or must not) be a terminal (either, when unset)
+}
+
+func (x *RuleMatch) Reset() {
+	*x = RuleMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleMatch) ProtoMessage() {}
+
+func (x *RuleMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleMatch.ProtoReflect.Descriptor instead.
+func (*RuleMatch) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RuleMatch) GetArgsRegex() string {
+	if x != nil {
+		return x.ArgsRegex
+	}
+	return ""
+}
+
+func (x *RuleMatch) GetArgsGlob() []string {
+	if x != nil {
+		return x.ArgsGlob
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetEnvSet() []string {
+	if x != nil {
+		return x.EnvSet
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetEnvEquals() map[string]string {
+	if x != nil {
+		return x.EnvEquals
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetCwdPrefix() []string {
+	if x != nil {
+		return x.CwdPrefix
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetUid() []uint32 {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetGid() []uint32 {
+	if x != nil {
+		return x.Gid
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetParent() []string {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetStdinTty() bool {
+	if x != nil && x.StdinTty != nil {
+		return *x.StdinTty
+	}
+	return false
+}
+
+func (x *RuleMatch) GetStdoutTty() bool {
+	if x != nil && x.StdoutTty != nil {
+		return *x.StdoutTty
+	}
+	return false
+}
+
+type Condition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommandExists []string `protobuf:"bytes,1,rep,name=command_exists,json=commandExists,proto3" json:"command_exists,omitempty"` // commands (names looked up along PATH or paths) that must all exist
+	FileExists    []string `protobuf:"bytes,2,rep,name=file_exists,json=fileExists,proto3" json:"file_exists,omitempty"`          // paths of files or directories that must all exist
+	Hostname      []string `protobuf:"bytes,3,rep,name=hostname,proto3" json:"hostname,omitempty"`                                // glob patterns, one of which must match the hostname (any hostname, when empty)
+	Arch          []string `protobuf:"bytes,4,rep,name=arch,proto3" json:"arch,omitempty"`                                        // architectures (as in GOARCH, for example amd64 or arm64), one of which must match the current one (any architecture, when empty)
+	EnvSet        []string `protobuf:"bytes,5,rep,name=env_set,json=envSet,proto3" json:"env_set,omitempty"`                      // names of environment variables that must all be set
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Condition) GetCommandExists() []string {
+	if x != nil {
+		return x.CommandExists
+	}
+	return nil
+}
+
+func (x *Condition) GetFileExists() []string {
+	if x != nil {
+		return x.FileExists
+	}
+	return nil
+}
+
+func (x *Condition) GetHostname() []string {
+	if x != nil {
+		return x.Hostname
+	}
+	return nil
+}
+
+func (x *Condition) GetArch() []string {
+	if x != nil {
+		return x.Arch
+	}
+	return nil
+}
+
+func (x *Condition) GetEnvSet() []string {
+	if x != nil {
+		return x.EnvSet
+	}
+	return nil
+}
+
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags        []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`               // targets with any of the given tags belong to the profile
+	Cmds        []string `protobuf:"bytes,2,rep,name=cmds,proto3" json:"cmds,omitempty"`               // targets with any of the given commands (as written in configuration, after variable expansion) belong to the profile
+	Description string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // free-form description of the profile
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_v2_config_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v2_config_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_config_v2_config_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Profile) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Profile) GetCmds() []string {
+	if x != nil {
+		return x.Cmds
+	}
+	return nil
+}
+
+func (x *Profile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_config_v2_config_proto protoreflect.FileDescriptor
+
+var file_config_v2_config_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x22,
+	0x29, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x03, 0x0a, 0x06, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x37, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x76, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x76, 0x61, 0x72,
+	0x73, 0x12, 0x47, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d,
+	0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x56, 0x61,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72,
+	0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xa0, 0x03, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x6d, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32,
+	0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x49, 0x6e, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x34,
+	0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69,
+	0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x77, 0x68, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x31, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x07, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12,
+	0x44, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f,
+	0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32,
+	0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x48, 0x00,
+	0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x22, 0x5c, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x63, 0x6d, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x72, 0x67,
+	0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x72, 0x67, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0xf2,
+	0x01, 0x0a, 0x0e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f,
+	0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x08, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72,
+	0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72,
+	0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x08, 0x72,
+	0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xa2, 0x02, 0x0a, 0x0f, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x69, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e,
+	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x72, 0x67, 0x73, 0x48, 0x00, 0x52, 0x06, 0x69, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x41,
+	0x72, 0x67, 0x73, 0x48, 0x00, 0x52, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x3e, 0x0a, 0x07, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69,
+	0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73,
+	0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x72,
+	0x65, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x75, 0x62, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72,
+	0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x6d, 0x61, 0x70, 0x53, 0x75, 0x62, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52,
+	0x0f, 0x72, 0x65, 0x6d, 0x61, 0x70, 0x53, 0x75, 0x62, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x37, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x74,
+	0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x74, 0x45, 0x6e,
+	0x64, 0x22, 0x3f, 0x0a, 0x08, 0x44, 0x72, 0x6f, 0x70, 0x41, 0x72, 0x67, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x45, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x6d,
+	0x61, 0x70, 0x53, 0x75, 0x62, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f,
+	0x22, 0x49, 0x0a, 0x0d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65,
+	0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xc3, 0x02, 0x0a, 0x0e,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22,
+	0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x5f, 0x30, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x72,
+	0x67, 0x30, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x40, 0x0a, 0x03, 0x65,
+	0x6e, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32,
+	0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x2a, 0x0a,
+	0x11, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e,
+	0x65, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x63,
+	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x66, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x46, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x78, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x38, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x22, 0xac, 0x03, 0x0a, 0x09,
+	0x52, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x67,
+	0x73, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x72, 0x67, 0x73, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x73,
+	0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x67,
+	0x73, 0x47, 0x6c, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x76, 0x53, 0x65, 0x74, 0x12, 0x4e,
+	0x0a, 0x0a, 0x65, 0x6e, 0x76, 0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x6e, 0x76, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x77, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x77, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x67, 0x69, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x03, 0x67, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x74, 0x64,
+	0x69, 0x6e, 0x5f, 0x74, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08,
+	0x73, 0x74, 0x64, 0x69, 0x6e, 0x54, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73,
+	0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x01, 0x52, 0x09, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x54, 0x74, 0x79, 0x88, 0x01, 0x01, 0x1a,
+	0x3c, 0x0a, 0x0e, 0x45, 0x6e, 0x76, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x5f, 0x74, 0x74, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x74, 0x79, 0x22, 0x9c, 0x01, 0x0a, 0x09, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x72, 0x63, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x76, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x6e, 0x76, 0x53, 0x65, 0x74, 0x22, 0x53, 0x0a, 0x07, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6d, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6d, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xd0,
+	0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63,
+	0x6d, 0x64, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x32, 0x42, 0x0b, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x69, 0x73, 0x68, 0x65, 0x2f, 0x69,
+	0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2f, 0x76, 0x32, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x76, 0x32, 0xa2, 0x02, 0x03,
+	0x49, 0x43, 0x58, 0xaa, 0x02, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d,
+	0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x15, 0x49, 0x6d,
+	0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x5c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5c, 0x56, 0x32, 0xe2, 0x02, 0x21, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d,
+	0x64, 0x5c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x63, 0x6d, 0x64, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x3a, 0x56,
+	0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_v2_config_proto_rawDescOnce sync.Once
+	file_config_v2_config_proto_rawDescData = file_config_v2_config_proto_rawDesc
+)
+
+func file_config_v2_config_proto_rawDescGZIP() []byte {
+	file_config_v2_config_proto_rawDescOnce.Do(func() {
+		file_config_v2_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_v2_config_proto_rawDescData)
+	})
+	return file_config_v2_config_proto_rawDescData
+}
+
+var file_config_v2_config_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_config_v2_config_proto_goTypes = []interface{}{
+	(*VersionEntity)(nil),   // 0: impostorcmd.config.v2.VersionEntity
+	(*Config)(nil),          // 1: impostorcmd.config.v2.Config
+	(*Target)(nil),          // 2: impostorcmd.config.v2.Target
+	(*Handler)(nil),         // 3: impostorcmd.config.v2.Handler
+	(*ExternalHandler)(nil), // 4: impostorcmd.config.v2.ExternalHandler
+	(*BuiltinHandler)(nil),  // 5: impostorcmd.config.v2.BuiltinHandler
+	(*ArgumentRewrite)(nil), // 6: impostorcmd.config.v2.ArgumentRewrite
+	(*InsertArgs)(nil),      // 7: impostorcmd.config.v2.InsertArgs
+	(*DropArgs)(nil),        // 8: impostorcmd.config.v2.DropArgs
+	(*ReplaceArgs)(nil),     // 9: impostorcmd.config.v2.ReplaceArgs
+	(*RemapSubcommand)(nil), // 10: impostorcmd.config.v2.RemapSubcommand
+	(*ScriptHandler)(nil),   // 11: impostorcmd.config.v2.ScriptHandler
+	(*RuntimeOptions)(nil),  // 12: impostorcmd.config.v2.RuntimeOptions
+	(*Rule)(nil),            // 13: impostorcmd.config.v2.Rule
+	(*RuleMatch)(nil),       // 14: impostorcmd.config.v2.RuleMatch
+	(*Condition)(nil),       // 15: impostorcmd.config.v2.Condition
+	(*Profile)(nil),         // 16: impostorcmd.config.v2.Profile
+	nil,                     // 17: impostorcmd.config.v2.Config.VarsEntry
+	nil,                     // 18: impostorcmd.config.v2.Config.ProfilesEntry
+	nil,                     // 19: impostorcmd.config.v2.BuiltinHandler.OptionsEntry
+	nil,                     // 20: impostorcmd.config.v2.RuntimeOptions.EnvEntry
+	nil,                     // 21: impostorcmd.config.v2.RuleMatch.EnvEqualsEntry
+}
+var file_config_v2_config_proto_depIdxs = []int32{
+	2,  // 0: impostorcmd.config.v2.Config.targets:type_name -> impostorcmd.config.v2.Target
+	17, // 1: impostorcmd.config.v2.Config.vars:type_name -> impostorcmd.config.v2.Config.VarsEntry
+	18, // 2: impostorcmd.config.v2.Config.profiles:type_name -> impostorcmd.config.v2.Config.ProfilesEntry
+	3,  // 3: impostorcmd.config.v2.Target.handler:type_name -> impostorcmd.config.v2.Handler
+	12, // 4: impostorcmd.config.v2.Target.runtime:type_name -> impostorcmd.config.v2.RuntimeOptions
+	15, // 5: impostorcmd.config.v2.Target.when:type_name -> impostorcmd.config.v2.Condition
+	13, // 6: impostorcmd.config.v2.Target.rules:type_name -> impostorcmd.config.v2.Rule
+	4,  // 7: impostorcmd.config.v2.Handler.external:type_name -> impostorcmd.config.v2.ExternalHandler
+	5,  // 8: impostorcmd.config.v2.Handler.builtin:type_name -> impostorcmd.config.v2.BuiltinHandler
+	11, // 9: impostorcmd.config.v2.Handler.script:type_name -> impostorcmd.config.v2.ScriptHandler
+	19, // 10: impostorcmd.config.v2.BuiltinHandler.options:type_name -> impostorcmd.config.v2.BuiltinHandler.OptionsEntry
+	6,  // 11: impostorcmd.config.v2.BuiltinHandler.rewrites:type_name -> impostorcmd.config.v2.ArgumentRewrite
+	7,  // 12: impostorcmd.config.v2.ArgumentRewrite.insert:type_name -> impostorcmd.config.v2.InsertArgs
+	8,  // 13: impostorcmd.config.v2.ArgumentRewrite.drop:type_name -> impostorcmd.config.v2.DropArgs
+	9,  // 14: impostorcmd.config.v2.ArgumentRewrite.replace:type_name -> impostorcmd.config.v2.ReplaceArgs
+	10, // 15: impostorcmd.config.v2.ArgumentRewrite.remap_subcommand:type_name -> impostorcmd.config.v2.RemapSubcommand
+	20, // 16: impostorcmd.config.v2.RuntimeOptions.env:type_name -> impostorcmd.config.v2.RuntimeOptions.EnvEntry
+	14, // 17: impostorcmd.config.v2.Rule.match:type_name -> impostorcmd.config.v2.RuleMatch
+	3,  // 18: impostorcmd.config.v2.Rule.handler:type_name -> impostorcmd.config.v2.Handler
+	21, // 19: impostorcmd.config.v2.RuleMatch.env_equals:type_name -> impostorcmd.config.v2.RuleMatch.EnvEqualsEntry
+	16, // 20: impostorcmd.config.v2.Config.ProfilesEntry.value:type_name -> impostorcmd.config.v2.Profile
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_config_v2_config_proto_init() }
+func file_config_v2_config_proto_init() {
+	if File_config_v2_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_v2_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionEntity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Target); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Handler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalHandler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltinHandler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgumentRewrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemapSubcommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScriptHandler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Condition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_v2_config_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_config_v2_config_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*Handler_External)(nil),
+		(*Handler_Builtin)(nil),
+		(*Handler_Script)(nil),
+	}
+	file_config_v2_config_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*ArgumentRewrite_Insert)(nil),
+		(*ArgumentRewrite_Drop)(nil),
+		(*ArgumentRewrite_Replace)(nil),
+		(*ArgumentRewrite_RemapSubcommand)(nil),
+	}
+	file_config_v2_config_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_v2_config_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_v2_config_proto_goTypes,
+		DependencyIndexes: file_config_v2_config_proto_depIdxs,
+		MessageInfos:      file_config_v2_config_proto_msgTypes,
+	}.Build()
+	File_config_v2_config_proto = out.File
+	file_config_v2_config_proto_rawDesc = nil
+	file_config_v2_config_proto_goTypes = nil
+	file_config_v2_config_proto_depIdxs = nil
+}
@@ -3,10 +3,14 @@ module github.com/daishe/impostorcmd
 go 1.20
 
 require (
+	github.com/BurntSushi/toml v1.2.1
 	github.com/bufbuild/buf v1.14.0
 	github.com/golang/protobuf v1.5.2
 	github.com/spf13/cobra v1.6.1
+	golang.org/x/sys v0.5.0
+	golang.org/x/term v0.5.0
 	google.golang.org/protobuf v1.28.2-0.20220831092852-f930b1dc76e8
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -57,9 +61,6 @@ require (
 	golang.org/x/mod v0.8.0 // indirect
 	golang.org/x/net v0.6.0 // indirect
 	golang.org/x/sync v0.1.0 // indirect
-	golang.org/x/sys v0.5.0 // indirect
-	golang.org/x/term v0.5.0 // indirect
 	golang.org/x/text v0.7.0 // indirect
 	golang.org/x/tools v0.6.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -14,7 +14,7 @@ import (
 var (
 	Version              = "development"
 	Commit               = "?"
-	ConfigurationVersion = "v1"
+	ConfigurationVersion = "v2"
 )
 
 func main() {
@@ -33,6 +33,9 @@ func main() {
 		if ee := (&exec.ExitError{}); errors.As(err, &ee) {
 			os.Exit(ee.ExitCode())
 		}
+		if ee := (action.ErrorExit{}); errors.As(err, &ee) {
+			os.Exit(ee.Code)
+		}
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
@@ -1,14 +1,14 @@
 package action
 
 import (
-	"crypto/rand"
-	"encoding/hex"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 	"io"
 	"os"
-	"runtime"
-	"strings"
+	"os/user"
+	"path/filepath"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 
@@ -16,159 +16,307 @@ import (
 	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
 )
 
-func Install(target *impostordatav1.TargetDescriptor) (Compensate, error) {
+type ExistingImpostorPolicy int
+
+const (
+	ExistingImpostorRefuse  ExistingImpostorPolicy = iota // refuse to install impostor for a target that already is an impostor
+	ExistingImpostorReplace                               // replace descriptor of the existing impostor, keeping its original command
+	ExistingImpostorStack                                 // deliberately impostor the existing impostor, adding another layer
+)
+
+var existingImpostorPolicyNames = map[ExistingImpostorPolicy]string{
+	ExistingImpostorRefuse:  "refuse",
+	ExistingImpostorReplace: "replace",
+	ExistingImpostorStack:   "stack",
+}
+
+func (p ExistingImpostorPolicy) String() string {
+	if n, ok := existingImpostorPolicyNames[p]; ok {
+		return n
+	}
+	return fmt.Sprintf("ExistingImpostorPolicy(%d)", int(p))
+}
+
+func ParseExistingImpostorPolicy(s string) (ExistingImpostorPolicy, error) {
+	for p, n := range existingImpostorPolicyNames {
+		if n == s {
+			return p, nil
+		}
+	}
+	return ExistingImpostorRefuse, fmt.Errorf("unknown policy %q for existing impostors (must be one of: refuse, replace, stack)", s)
+}
+
+type ErrorAlreadyImpostor struct {
+	Path string
+}
+
+func (e ErrorAlreadyImpostor) Error() string {
+	return fmt.Sprintf("%s already is an impostor", e.Path)
+}
+
+type InstallOptions struct {
+	Existing ExistingImpostorPolicy // what to do when target already is an impostor
+	Version  string                 // impostorcmd version recorded in install provenance
+	Commit   string                 // impostorcmd commit hash recorded in install provenance
+	SignKey  ed25519.PrivateKey     // key to sign descriptor with (nil for unsigned descriptor)
+
+	// PinImpostor makes impostor command (or script interpreter) resolved during install instead of on every invocation. Both the resolved path and the hash of the impostor command are recorded and the impostor refuses to run when either changes. Targets may request pinning on their own (see PinImpostor field of the descriptor).
+	PinImpostor bool
+}
+
+func Install(target *impostordatav1.TargetDescriptor, o InstallOptions) (*Transaction, error) {
 	target = proto.Clone(target).(*impostordatav1.TargetDescriptor)
-	c := Compensate(nil)
+	tx := &Transaction{}
 
 	selfPath, err := os.Executable()
 	if err != nil {
-		return c, fmt.Errorf("obtaining impostorcmd: %w", err)
+		return tx, fmt.Errorf("obtaining impostorcmd: %w", err)
 	}
 
-	originalCmd := target.OriginalCmd
-	originalCmdMoved, err := appendRandomPathSuffixFileNoExists(target.OriginalCmd)
-	if err != nil {
-		return c, fmt.Errorf("moving original command: %w", err)
+	stampProvenance(target, o)
+
+	if err := ValidateHandler(target); err != nil {
+		return tx, err
+	}
+	target.PinImpostor = target.PinImpostor || o.PinImpostor
+	if target.PinImpostor && handlerCmd(target) != "" { // builtin handlers run no command, that could be pinned
+		if target.ImpostorPin, err = pinImpostor(handlerCmd(target)); err != nil {
+			return tx, fmt.Errorf("pinning impostor command: %w", err)
+		}
+	}
+	for i, r := range target.Rules {
+		if cmd := handlerCmd(ruleDescriptor(target, r)); target.PinImpostor && cmd != "" {
+			if r.ImpostorPin, err = pinImpostor(cmd); err != nil {
+				return tx, fmt.Errorf("pinning impostor command of rule #%d: %w", i+1, err)
+			}
+		}
 	}
-	target.OriginalCmd = originalCmdMoved
 
-	mvUndo, err := mv(originalCmdMoved, originalCmd)
-	c.With(mvUndo)
-	if err != nil {
-		return c, fmt.Errorf("moving original command: %w", err)
+	if err := checkPolicy(target.OriginalCmd, target, handlerDescriptors(target)); err != nil {
+		return tx, err
 	}
 
-	copy := func(dst *os.File, src *os.File) error {
-		if _, err := io.Copy(dst, src); err != nil {
-			return err
+	dir, err := openTargetDir(tx, filepath.Dir(target.OriginalCmd))
+	if err != nil {
+		return tx, fmt.Errorf("opening target directory: %w", err)
+	}
+	targetName := filepath.Base(target.OriginalCmd)
+	existing := (*impostordatav1.TargetDescriptor)(nil)
+	targetID, err := dir.readIdentified(targetName, func(f *os.File) (err error) {
+		if existing, err = descriptor.FromExecutable(f); err != nil && !errors.As(err, &descriptor.ErrorNoDescriptor{}) {
+			return fmt.Errorf("checking whether target already is an impostor: %w", err)
 		}
-		if err := descriptor.AppendToExecutable(dst, target); err != nil {
-			return err
+		if target.OriginalFingerprint, err = descriptor.FingerprintFile(f); err != nil {
+			return fmt.Errorf("capturing original command fingerprint: %w", err)
 		}
-		return dst.Sync()
-	}
-	cpUndo, err := cp(originalCmd, selfPath, originalCmdMoved, copy)
-	c.With(cpUndo)
+		return nil
+	})
 	if err != nil {
-		return c, fmt.Errorf("attempting to impostor command: %w", err)
+		return tx, fmt.Errorf("reading target: %w", err)
+	}
+	if existing != nil {
+		switch o.Existing {
+		case ExistingImpostorReplace:
+			return tx, replace(tx, dir, target, targetID, existing, selfPath, o.SignKey)
+		case ExistingImpostorStack:
+			target.StackDepth = existing.StackDepth + 1
+		default:
+			return tx, ErrorAlreadyImpostor{Path: target.OriginalCmd}
+		}
 	}
 
-	return c, nil
-}
-
-func Uninstall(cmd string) (Compensate, error) {
-	c := Compensate(nil)
-
-	cmd, err := descriptor.Lookup(cmd)
+	originalCmdMoved, err := mvToRandomSibling(tx, dir, targetName, targetID)
 	if err != nil {
-		return c, err
+		return tx, fmt.Errorf("moving original command: %w", err)
 	}
+	target.OriginalCmd = dir.join(originalCmdMoved)
 
-	desc, err := loadDescriptor(cmd)
+	originalStat, err := dir.statVerified(originalCmdMoved, targetID)
 	if err != nil {
-		return c, err
+		return tx, fmt.Errorf("moving original command: %w", err)
 	}
+	target.OriginalFingerprint.Identity = descriptor.FileIdentityOf(originalStat) // moving changes status change time, so identity is captured only once the original command is in place
+	if err := cp(tx, dir, targetName, selfPath, originalStat, copyWithDescriptor(target, o.SignKey)); err != nil {
+		return tx, fmt.Errorf("attempting to impostor command: %w", err)
+	}
+
+	return tx, nil
+}
 
-	cmdTmp, err := appendRandomPathSuffixFileNoExists(cmd)
+// stampProvenance records when, by whom, where and with what version of impostorcmd the given target is being installed.
+func stampProvenance(target *impostordatav1.TargetDescriptor, o InstallOptions) {
+	if target.Provenance == nil {
+		target.Provenance = &impostordatav1.Provenance{}
+	}
+	p := target.Provenance
+	p.InstalledAtUnixNano = time.Now().UnixNano()
+	p.ImpostorcmdVersion = o.Version
+	p.ImpostorcmdCommit = o.Commit
+	if u, err := user.Current(); err == nil {
+		p.User, p.Uid = u.Username, u.Uid
+	}
+	p.SudoUser = os.Getenv("SUDO_USER")
+	if h, err := os.Hostname(); err == nil {
+		p.Hostname = h
+	}
+}
+
+func pinImpostor(impostorCmd string) (*impostordatav1.ImpostorPin, error) {
+	path, err := descriptor.Lookup(impostorCmd)
 	if err != nil {
-		return c, fmt.Errorf("moving impostor command: %w", err)
+		return nil, err
 	}
-	mvCmdUndo, err := mv(cmdTmp, cmd)
-	c.With(mvCmdUndo)
+	fp, err := descriptor.Fingerprint(path)
 	if err != nil {
-		return c, fmt.Errorf("moving impostor command: %w", err)
+		return nil, err
 	}
+	return &impostordatav1.ImpostorPin{Path: path, Sha256: fp.Sha256}, nil
+}
+
+// replace swaps descriptor of an existing impostor for the given one, while preserving the original command (and stack depth) of the existing impostor.
+func replace(tx *Transaction, dir *targetDir, target *impostordatav1.TargetDescriptor, impostorID fileIdentity, existing *impostordatav1.TargetDescriptor, selfPath string, signKey ed25519.PrivateKey) error {
+	impostorCmd := filepath.Base(target.OriginalCmd)
+	target.OriginalCmd = existing.OriginalCmd
+	target.StackDepth = existing.StackDepth
+	target.OriginalFingerprint = existing.OriginalFingerprint
 
-	mvOriginalUndo, err := mv(cmd, desc.OriginalCmd)
-	c.With(mvOriginalUndo)
+	impostorCmdTmp, err := mvToRandomSibling(tx, dir, impostorCmd, impostorID)
 	if err != nil {
-		return c, fmt.Errorf("moving original command: %w", err)
+		return fmt.Errorf("moving existing impostor command: %w", err)
 	}
 
-	if err = os.Remove(cmdTmp); err != nil {
-		return c, fmt.Errorf("removing impostor command: %w", err)
+	impostorStat, err := dir.statVerified(impostorCmdTmp, impostorID)
+	if err != nil {
+		return fmt.Errorf("moving existing impostor command: %w", err)
+	}
+	if err := cp(tx, dir, impostorCmd, selfPath, impostorStat, copyWithDescriptor(target, signKey)); err != nil {
+		return fmt.Errorf("attempting to replace impostor command: %w", err)
 	}
-	return nil, nil // removal cannot be undone
+
+	rm(tx, dir, impostorCmdTmp, impostorID) // removal cannot be undone, so it is deferred until commit
+	return nil
 }
 
-func loadDescriptor(path string) (*impostordatav1.TargetDescriptor, error) {
-	cmdFile, err := os.Open(path)
+type UninstallOptions struct {
+	Force bool // restore original command even if it does not match its recorded fingerprint
+}
+
+func Uninstall(cmd string, o UninstallOptions) (*Transaction, error) {
+	tx := &Transaction{}
+
+	cmd, err := descriptor.Lookup(cmd)
 	if err != nil {
-		return nil, fmt.Errorf("reading command file: %w", err)
+		return tx, err
 	}
-	defer cmdFile.Close()
-	desc, err := descriptor.FromExecutable(cmdFile)
+
+	dir, err := openTargetDir(tx, filepath.Dir(cmd))
 	if err != nil {
-		return nil, fmt.Errorf("while reading %s: %w", path, err)
+		return tx, fmt.Errorf("opening command directory: %w", err)
+	}
+	cmdName := filepath.Base(cmd)
+	desc := (*impostordatav1.TargetDescriptor)(nil)
+	cmdID, err := dir.readIdentified(cmdName, func(f *os.File) (err error) {
+		desc, err = descriptor.FromExecutable(f)
+		return err
+	})
+	if err != nil {
+		return tx, fmt.Errorf("reading command file %s: %w", cmd, err)
 	}
-	return desc, nil
-}
 
-func appendRandomPathSuffixFileNoExists(path string) (string, error) {
-	for {
-		suffixBytes := make([]byte, 16)
-		if _, err := rand.Read(suffixBytes); err != nil {
-			return "", err
-		}
-		suffix := "-" + hex.EncodeToString(suffixBytes)
-
-		newPath := ""
-		switch {
-		case runtime.GOOS == "windows" && strings.HasSuffix(path, ".exe"):
-			newPath = strings.TrimSuffix(path, ".exe") + suffix + ".exe"
-		case runtime.GOOS == "windows" && strings.HasSuffix(path, ".bat"):
-			newPath = strings.TrimSuffix(path, ".bat") + suffix + ".bat"
-		default:
-			newPath = path + suffix
+	originalName, err := dir.name(desc.OriginalCmd) // original command is always moved next to the impostor
+	if err != nil {
+		return tx, fmt.Errorf("locating original command: %w", err)
+	}
+	originalID, err := dir.readIdentified(originalName, func(f *os.File) error {
+		if o.Force {
+			return nil
 		}
-
-		if _, err := os.Stat(newPath); err == nil {
-			continue
-		} else if !errors.Is(err, os.ErrNotExist) {
-			return "", err
+		if err := descriptor.VerifyFileFingerprint(f, desc.OriginalFingerprint); err != nil && !errors.As(err, &descriptor.ErrorNoFingerprint{}) {
+			return fmt.Errorf("verifying original command: %w", err)
 		}
-		return newPath, nil
+		return nil
+	})
+	if err != nil {
+		return tx, fmt.Errorf("reading original command file: %w", err)
 	}
-}
 
-func mv(dst, src string) (undo Compensate, err error) {
-	err = os.Rename(src, dst)
+	cmdTmp, err := mvToRandomSibling(tx, dir, cmdName, cmdID)
 	if err != nil {
-		return undo, err
+		return tx, fmt.Errorf("moving impostor command: %w", err)
 	}
-	undo = func() error {
-		return os.Rename(dst, src)
+
+	if err := mv(tx, dir, cmdName, originalName, originalID); err != nil {
+		return tx, fmt.Errorf("moving original command: %w", err)
 	}
-	return undo, nil
+
+	rm(tx, dir, cmdTmp, cmdID) // removal cannot be undone, so it is deferred until commit
+	return tx, nil
 }
 
-func cp(dst, src string, modOwnerRef string, copy func(*os.File, *os.File) error) (undo Compensate, err error) {
-	refStat, err := os.Stat(modOwnerRef)
+// Describe returns descriptor of the given impostor command. If the command is not an impostor, descriptor.ErrorNoDescriptor error is returned.
+func Describe(cmd string) (*impostordatav1.TargetDescriptor, error) {
+	cmd, err := descriptor.Lookup(cmd)
 	if err != nil {
-		return undo, err
+		return nil, err
 	}
+	return loadDescriptor(cmd)
+}
 
-	srcFile, err := os.Open(src)
+// DescribeSigned returns descriptor of the given impostor command together with its signature. If the command is not an impostor, descriptor.ErrorNoDescriptor error is returned.
+func DescribeSigned(cmd string) (*descriptor.Signed, error) {
+	cmd, err := descriptor.Lookup(cmd)
 	if err != nil {
-		return undo, err
+		return nil, err
 	}
-	defer srcFile.Close()
-
-	dstFile, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, refStat.Mode())
+	cmdFile, err := os.Open(cmd)
 	if err != nil {
-		return undo, err
+		return nil, fmt.Errorf("reading command file: %w", err)
 	}
-	defer dstFile.Close()
-	undo = func() error {
-		return os.Remove(dst)
+	defer cmdFile.Close()
+	signed, err := descriptor.SignedFromExecutable(cmdFile)
+	if err != nil {
+		return nil, fmt.Errorf("while reading %s: %w", cmd, err)
 	}
+	return signed, nil
+}
+
+// VerifyOriginal checks whether the original command of the given impostor matches fingerprint recorded during install. If no fingerprint has been recorded, descriptor.ErrorNoFingerprint error is returned.
+func VerifyOriginal(desc *impostordatav1.TargetDescriptor) error {
+	return descriptor.VerifyFingerprint(desc.OriginalCmd, desc.OriginalFingerprint)
+}
 
-	if err = copy(dstFile, srcFile); err != nil {
-		return undo, err
+// quickVerifyOriginal checks the original command of the given impostor as VerifyOriginal does, but hashes it only when its identity or metadata differ from the recorded ones (see descriptor.QuickVerifyFingerprint), as it is done on every invocation.
+func quickVerifyOriginal(desc *impostordatav1.TargetDescriptor) error {
+	return descriptor.QuickVerifyFingerprint(desc.OriginalCmd, desc.OriginalFingerprint)
+}
+
+func copyWithDescriptor(desc *impostordatav1.TargetDescriptor, signKey ed25519.PrivateKey) func(*os.File, *os.File) error {
+	return func(dst *os.File, src *os.File) error {
+		if _, err := io.Copy(dst, src); err != nil {
+			return err
+		}
+		appendErr := error(nil)
+		if signKey != nil {
+			appendErr = descriptor.AppendSignedToExecutable(dst, desc, signKey)
+		} else {
+			appendErr = descriptor.AppendToExecutable(dst, desc)
+		}
+		if appendErr != nil {
+			return appendErr
+		}
+		return dst.Sync()
 	}
+}
 
-	if _, err = tryFChown(dstFile, refStat); err != nil {
-		return undo, err
+func loadDescriptor(path string) (*impostordatav1.TargetDescriptor, error) {
+	cmdFile, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading command file: %w", err)
 	}
-	return undo, nil
+	defer cmdFile.Close()
+	desc, err := descriptor.FromExecutable(cmdFile)
+	if err != nil {
+		return nil, fmt.Errorf("while reading %s: %w", path, err)
+	}
+	return desc, nil
 }
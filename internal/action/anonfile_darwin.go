@@ -0,0 +1,12 @@
+//go:build darwin
+
+package action
+
+import (
+	"os"
+)
+
+// createAnonymousFile creates a file, that lives only as long as it is open. The system offers no way of creating a file without a name, so a temporary file is removed right away.
+func createAnonymousFile() (*os.File, error) {
+	return createTempAnonymousFile()
+}
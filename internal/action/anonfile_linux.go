@@ -0,0 +1,20 @@
+//go:build linux
+
+package action
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// createAnonymousFile creates a file, that never has a name in any directory (so no other process can open it by path). Memory backed file is preferred, then unnamed file in the temporary directory, then a temporary file removed right away (on old kernels).
+func createAnonymousFile() (*os.File, error) {
+	if fd, err := unix.MemfdCreate("impostorcmd-control", unix.MFD_CLOEXEC); err == nil {
+		return os.NewFile(uintptr(fd), "memfd:impostorcmd-control"), nil
+	}
+	if fd, err := unix.Open(os.TempDir(), unix.O_RDWR|unix.O_TMPFILE|unix.O_CLOEXEC, 0o600); err == nil {
+		return os.NewFile(uintptr(fd), os.TempDir()), nil
+	}
+	return createTempAnonymousFile()
+}
@@ -0,0 +1,14 @@
+//go:build !(linux || darwin)
+
+package action
+
+import (
+	"os"
+
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+// openControl creates control file for handler of the given target. This function is a dummy implementation, that always returns nil file, as passing additional file descriptors to child processes is not supported on the given system.
+func openControl(target *impostordatav1.TargetDescriptor) (*os.File, error) {
+	return nil, nil
+}
@@ -0,0 +1,30 @@
+//go:build linux || darwin
+
+package action
+
+import (
+	"os"
+
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+// openControl creates anonymous control file for handler of the given target or returns nil, when the target does not use one.
+func openControl(target *impostordatav1.TargetDescriptor) (*os.File, error) {
+	if !target.DeclineControlFd {
+		return nil, nil
+	}
+	return createAnonymousFile()
+}
+
+// createTempAnonymousFile creates a file in the temporary directory and removes it right away, so that it lives only as long as it is open.
+func createTempAnonymousFile() (*os.File, error) {
+	f, err := os.CreateTemp("", "impostorcmd-control-*")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Remove(f.Name()); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
@@ -0,0 +1,179 @@
+package action
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/daishe/impostorcmd/internal/descriptor"
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+// ErrorImpostorCycle is returned when impostors (directly or through script interpreters) end up running one another in a loop, which would recurse infinitely at runtime.
+type ErrorImpostorCycle struct {
+	Chain []string // commands forming the cycle, starting and ending with the same command
+}
+
+func (e ErrorImpostorCycle) Error() string {
+	return fmt.Sprintf("impostor cycle: %s", strings.Join(e.Chain, " -> "))
+}
+
+// ErrorImpostorSelfReference is returned when a handler (directly or through other impostors) runs impostorcmd itself.
+type ErrorImpostorSelfReference struct {
+	Chain []string // commands leading from the target to impostorcmd
+}
+
+func (e ErrorImpostorSelfReference) Error() string {
+	return fmt.Sprintf("impostor runs impostorcmd itself: %s", strings.Join(e.Chain, " -> "))
+}
+
+// CheckCycles analyses handlers of the given targets (about to be installed) together with impostors already installed on the machine and reports every cycle of impostors running one another and every target, whose handler runs impostorcmd itself. Commands are followed through handler commands (including handlers of rules), script interpreters and interpreters named by shebang lines of handler scripts. Commands, that cannot be resolved, end the analysis of their chain (they are reported elsewhere).
+func CheckCycles(targets []*impostordatav1.TargetDescriptor) error {
+	selfPath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("obtaining impostorcmd: %w", err)
+	}
+	self, err := os.Stat(selfPath)
+	if err != nil {
+		return fmt.Errorf("obtaining impostorcmd: %w", err)
+	}
+
+	g := &cycleGraph{planned: map[string]*impostordatav1.TargetDescriptor{}, self: self, done: map[string]bool{}, reported: map[string]bool{}}
+	for _, t := range targets {
+		g.planned[t.OriginalCmd] = t
+	}
+	for _, t := range targets {
+		g.visit(t.OriginalCmd)
+	}
+	return errors.Join(g.errs...)
+}
+
+type cycleGraph struct {
+	planned  map[string]*impostordatav1.TargetDescriptor // targets about to be installed, by path of the original command
+	self     os.FileInfo
+	chain    []string        // chain of commands currently being followed
+	done     map[string]bool // commands, whose every chain has already been followed
+	reported map[string]bool // cycles already reported (by their canonical form)
+	errs     []error
+}
+
+func (g *cycleGraph) visit(path string) {
+	if i := indexOf(g.chain, path); i >= 0 {
+		g.cycle(g.chain[i:])
+		return
+	}
+	if g.done[path] {
+		return
+	}
+	g.chain = append(g.chain, path)
+	defer func() { g.chain = g.chain[:len(g.chain)-1] }()
+	for _, next := range g.runs(path) {
+		if stat, err := os.Stat(next); err == nil && os.SameFile(stat, g.self) {
+			g.errs = append(g.errs, ErrorImpostorSelfReference{Chain: append(append([]string(nil), g.chain...), next)})
+			continue
+		}
+		g.visit(next)
+	}
+	g.done[path] = true
+}
+
+func indexOf(l []string, s string) int {
+	for i, v := range l {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// cycle reports the given cycle of commands, unless it has already been reported (for example, when reached from another of its commands).
+func (g *cycleGraph) cycle(cycle []string) {
+	start := 0 // the same cycle may be entered from any of its commands, so it is reported starting from the lexically smallest one
+	for i, p := range cycle {
+		if p < cycle[start] {
+			start = i
+		}
+	}
+	chain := append(append([]string(nil), cycle[start:]...), cycle[:start]...)
+	chain = append(chain, chain[0])
+	key := strings.Join(chain, "\x00")
+	if g.reported[key] {
+		return
+	}
+	g.reported[key] = true
+	g.errs = append(g.errs, ErrorImpostorCycle{Chain: chain})
+}
+
+// runs returns resolved paths of commands run by the command under the given path, that is handler commands of an impostor (either about to be installed or already installed, including handlers of its rules) or the shebang interpreter of a script. Commands, that cannot be resolved, are omitted.
+func (g *cycleGraph) runs(path string) []string {
+	desc, ok := g.planned[path]
+	if !ok {
+		desc = installedDescriptor(path)
+	}
+	if desc == nil {
+		if interpreter := shebangInterpreter(path); interpreter != "" {
+			if resolved, err := descriptor.Lookup(interpreter); err == nil {
+				return []string{resolved}
+			}
+		}
+		return nil
+	}
+	if ValidateHandler(desc) != nil {
+		return nil
+	}
+	cmds := []string(nil)
+	for _, d := range handlerDescriptors(desc) {
+		if handlerCmd(d) == "" { // builtin handlers run no command (passthrough runs the moved original, that is not an impostor)
+			continue
+		}
+		if pin := d.ImpostorPin; pin != nil {
+			cmds = append(cmds, pin.Path)
+		} else if resolved, err := descriptor.Lookup(handlerCmd(d)); err == nil {
+			cmds = append(cmds, resolved)
+		}
+	}
+	return cmds
+}
+
+// installedDescriptor returns descriptor of the impostor installed under the given path or nil, when the command is not an impostor (or cannot be read).
+func installedDescriptor(path string) *impostordatav1.TargetDescriptor {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	desc, err := descriptor.FromExecutable(f)
+	if err != nil {
+		return nil
+	}
+	return desc
+}
+
+// shebangInterpreter returns the command named by the shebang line of the script under the given path (following env, as in "#!/usr/bin/env python3") or empty string, when the file is not a script.
+func shebangInterpreter(path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	line, _ := bufio.NewReaderSize(f, 256).ReadSlice('\n') // partial line (at the end of file or over the buffer size) is fine
+	if !strings.HasPrefix(string(line), "#!") {
+		return ""
+	}
+	fields := strings.Fields(strings.TrimPrefix(string(line), "#!"))
+	if len(fields) == 0 {
+		return ""
+	}
+	if filepath.Base(fields[0]) != "env" {
+		return fields[0]
+	}
+	for _, f := range fields[1:] {
+		if !strings.HasPrefix(f, "-") && !strings.Contains(f, "=") { // skip env options and variable assignments
+			return f
+		}
+	}
+	return ""
+}
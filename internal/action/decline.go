@@ -0,0 +1,50 @@
+package action
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+const (
+	controlFdEnv  = "IMPOSTORCMD_CONTROL_FD" // number of the control file descriptor passed to handlers
+	declineMarker = "decline"                // line written to the control file descriptor by handlers declining the invocation
+)
+
+// maximal amount of data read back from the control file
+const maxControlSize = 64 * 1024
+
+// withControl passes the given control file to the given handler command, when the given target uses one.
+func withControl(cmd *exec.Cmd, target *impostordatav1.TargetDescriptor, control *os.File) {
+	if control == nil {
+		return
+	}
+	cmd.ExtraFiles = append(cmd.ExtraFiles, control)
+	cmd.Env = append(cmd.Env, controlFdEnv+"="+strconv.Itoa(2+len(cmd.ExtraFiles))) // extra files follow standard streams
+}
+
+// declined checks whether handler of the given target, that finished with the given error, declined the invocation, either by exiting with the decline exit code or by writing decline marker to the given control file.
+func declined(target *impostordatav1.TargetDescriptor, runErr error, control *os.File) (bool, error) {
+	if ee := (&exec.ExitError{}); target.DeclineExitCode != 0 && errors.As(runErr, &ee) && ee.ExitCode() == int(target.DeclineExitCode) {
+		return true, nil
+	}
+	if control == nil {
+		return false, nil
+	}
+	if _, err := control.Seek(0, io.SeekStart); err != nil { // handler moved the shared offset while writing
+		return false, err
+	}
+	s := bufio.NewScanner(io.LimitReader(control, maxControlSize))
+	for s.Scan() {
+		if strings.TrimSpace(s.Text()) == declineMarker {
+			return true, nil
+		}
+	}
+	return false, s.Err()
+}
@@ -0,0 +1,220 @@
+package action
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+type ErrorFileSwapped struct {
+	Path string
+}
+
+func (e ErrorFileSwapped) Error() string {
+	return fmt.Sprintf("file %s has been replaced by another file during operation", e.Path)
+}
+
+// randomSiblingPath returns path (or name, when given a name) of a file in the same directory as the given one, with random suffix appended to its name. It does not check whether the file exists.
+func randomSiblingPath(path string) (string, error) {
+	suffixBytes := make([]byte, 16)
+	if _, err := rand.Read(suffixBytes); err != nil {
+		return "", err
+	}
+	suffix := "-" + hex.EncodeToString(suffixBytes)
+
+	switch {
+	case runtime.GOOS == "windows" && strings.HasSuffix(path, ".exe"):
+		return strings.TrimSuffix(path, ".exe") + suffix + ".exe", nil
+	case runtime.GOOS == "windows" && strings.HasSuffix(path, ".bat"):
+		return strings.TrimSuffix(path, ".bat") + suffix + ".bat", nil
+	}
+	return path + suffix, nil
+}
+
+// openTargetDir opens the given directory for the whole operation recorded by the given transaction and releases it when the transaction is either committed or rolled back.
+func openTargetDir(tx *Transaction, dir string) (*targetDir, error) {
+	d, err := openDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	tx.Release("close "+dir, d.close)
+	return d, nil
+}
+
+// join returns path of the file with the given name within the directory.
+func (d *targetDir) join(name string) string {
+	return filepath.Join(d.path, name)
+}
+
+// verify ensures that the directory held open is still the directory under its path.
+func (d *targetDir) verify() error {
+	info, err := os.Stat(d.path)
+	if err != nil {
+		return err
+	}
+	if !identifyInfo(info).same(d.id) {
+		return ErrorFileSwapped{Path: d.path}
+	}
+	return nil
+}
+
+// name returns name of the file under the given path within the directory, failing when the file is located in another directory.
+func (d *targetDir) name(path string) (string, error) {
+	if dir := filepath.Dir(path); dir != d.path {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return "", err
+		}
+		if !identifyInfo(info).same(d.id) {
+			return "", fmt.Errorf("file %s is not located in directory %s", path, d.path)
+		}
+	}
+	return filepath.Base(path), nil
+}
+
+// openIdentified opens file with the given name for reading without following symbolic links and returns it together with its identity.
+func (d *targetDir) openIdentified(name string) (*os.File, fileIdentity, error) {
+	if err := d.verify(); err != nil {
+		return nil, fileIdentity{}, err
+	}
+	f, err := d.openFile(name, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, fileIdentity{}, err
+	}
+	id, err := identifyFile(f)
+	if err != nil {
+		f.Close()
+		return nil, fileIdentity{}, err
+	}
+	return f, id, nil
+}
+
+// readIdentified opens file with the given name as openIdentified does, passes it to the given function and closes it, returning its identity. Files are never kept open while being moved or removed, as some systems (like Windows) refuse to do so with open files, so every later step verifies the identity instead.
+func (d *targetDir) readIdentified(name string, read func(*os.File) error) (fileIdentity, error) {
+	f, id, err := d.openIdentified(name)
+	if err != nil {
+		return fileIdentity{}, err
+	}
+	defer f.Close()
+	if err := read(f); err != nil {
+		return fileIdentity{}, err
+	}
+	return id, nil
+}
+
+// statVerified returns information about the file with the given name, ensuring it is the expected file.
+func (d *targetDir) statVerified(name string, expected fileIdentity) (os.FileInfo, error) {
+	f, id, err := d.openIdentified(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if !id.same(expected) {
+		return nil, ErrorFileSwapped{Path: d.join(name)}
+	}
+	return f.Stat()
+}
+
+// renameVerified moves the expected file from src to dst (both being names within the directory), failing if dst already exists. If the file moved turns out not to be the expected one, the move is reverted.
+func (d *targetDir) renameVerified(dst, src string, expected fileIdentity) error {
+	if err := d.verify(); err != nil {
+		return err
+	}
+	if err := d.renameNoReplace(src, dst); err != nil {
+		return err
+	}
+	moved, err := d.identify(dst)
+	if err == nil && moved.same(expected) {
+		return nil
+	}
+	if revertErr := d.renameNoReplace(dst, src); revertErr != nil {
+		return errors.Join(ErrorFileSwapped{Path: d.join(src)}, fmt.Errorf("reverting move: %w", revertErr))
+	}
+	return ErrorFileSwapped{Path: d.join(src)}
+}
+
+// removeVerified removes the file with the given name, ensuring it is the expected file.
+func (d *targetDir) removeVerified(name string, expected fileIdentity) error {
+	if err := d.verify(); err != nil {
+		return err
+	}
+	id, err := d.identify(name)
+	if err != nil {
+		return err
+	}
+	if !id.same(expected) {
+		return ErrorFileSwapped{Path: d.join(name)}
+	}
+	return d.remove(name)
+}
+
+func mv(tx *Transaction, d *targetDir, dst, src string, expected fileIdentity) error {
+	if err := d.renameVerified(dst, src, expected); err != nil {
+		return err
+	}
+	tx.Step("move "+d.join(src)+" to "+d.join(dst), func() error {
+		return d.renameVerified(src, dst, expected)
+	})
+	return nil
+}
+
+// mvToRandomSibling moves the expected file to a new, random name in the same directory and returns the new name.
+func mvToRandomSibling(tx *Transaction, d *targetDir, src string, expected fileIdentity) (string, error) {
+	for {
+		dst, err := randomSiblingPath(src)
+		if err != nil {
+			return "", err
+		}
+		if err := mv(tx, d, dst, src, expected); err != nil {
+			if errors.Is(err, os.ErrExist) {
+				continue
+			}
+			return "", err
+		}
+		return dst, nil
+	}
+}
+
+func rm(tx *Transaction, d *targetDir, name string, expected fileIdentity) {
+	tx.OnCommit("remove "+d.join(name), func() error {
+		return d.removeVerified(name, expected)
+	})
+}
+
+func cp(tx *Transaction, d *targetDir, dst, src string, modOwnerRef os.FileInfo, copy func(*os.File, *os.File) error) error {
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+
+	if err := d.verify(); err != nil {
+		return err
+	}
+	dstFile, err := d.openFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, modOwnerRef.Mode())
+	if err != nil {
+		return err
+	}
+	defer dstFile.Close()
+	dstID, err := identifyFile(dstFile)
+	if err != nil {
+		return err
+	}
+	tx.Step("create "+d.join(dst), func() error {
+		return d.removeVerified(dst, dstID)
+	})
+
+	if err = copy(dstFile, srcFile); err != nil {
+		return err
+	}
+
+	if _, err = tryFChown(dstFile, modOwnerRef); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+//go:build linux
+
+package action
+
+import (
+	"errors"
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// fileIdentity identifies a file independently of its path.
+type fileIdentity struct {
+	dev uint64
+	ino uint64
+}
+
+func (id fileIdentity) same(other fileIdentity) bool {
+	return id == other
+}
+
+func identifyFile(f *os.File) (fileIdentity, error) {
+	st := unix.Stat_t{}
+	if err := unix.Fstat(int(f.Fd()), &st); err != nil {
+		return fileIdentity{}, &os.PathError{Op: "fstat", Path: f.Name(), Err: err}
+	}
+	return fileIdentity{dev: uint64(st.Dev), ino: st.Ino}, nil
+}
+
+func identifyInfo(info os.FileInfo) fileIdentity {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fileIdentity{}
+	}
+	return fileIdentity{dev: uint64(st.Dev), ino: st.Ino}
+}
+
+// targetDir is a directory held open for the whole operation on files within it, so that every file is accessed relative to the very same directory.
+type targetDir struct {
+	path string
+	id   fileIdentity
+	fd   int
+}
+
+func openDir(dir string) (*targetDir, error) {
+	fd, err := unix.Open(dir, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: dir, Err: err}
+	}
+	st := unix.Stat_t{}
+	if err := unix.Fstat(fd, &st); err != nil {
+		unix.Close(fd)
+		return nil, &os.PathError{Op: "fstat", Path: dir, Err: err}
+	}
+	return &targetDir{path: dir, id: fileIdentity{dev: uint64(st.Dev), ino: st.Ino}, fd: fd}, nil
+}
+
+func (d *targetDir) close() error {
+	if err := unix.Close(d.fd); err != nil {
+		return &os.PathError{Op: "close", Path: d.path, Err: err}
+	}
+	return nil
+}
+
+// identify returns identity of the file with the given name, without following symbolic links.
+func (d *targetDir) identify(name string) (fileIdentity, error) {
+	st := unix.Stat_t{}
+	if err := unix.Fstatat(d.fd, name, &st, unix.AT_SYMLINK_NOFOLLOW); err != nil {
+		return fileIdentity{}, &os.PathError{Op: "fstatat", Path: d.join(name), Err: err}
+	}
+	return fileIdentity{dev: uint64(st.Dev), ino: st.Ino}, nil
+}
+
+// openFile opens file with the given name, without following symbolic links.
+func (d *targetDir) openFile(name string, flag int, perm os.FileMode) (*os.File, error) {
+	fd, err := unix.Openat(d.fd, name, flag|unix.O_NOFOLLOW|unix.O_CLOEXEC, unixMode(perm))
+	if err != nil {
+		return nil, &os.PathError{Op: "openat", Path: d.join(name), Err: err}
+	}
+	return os.NewFile(uintptr(fd), d.join(name)), nil
+}
+
+func unixMode(perm os.FileMode) uint32 {
+	m := uint32(perm.Perm())
+	if perm&os.ModeSetuid != 0 {
+		m |= unix.S_ISUID
+	}
+	if perm&os.ModeSetgid != 0 {
+		m |= unix.S_ISGID
+	}
+	if perm&os.ModeSticky != 0 {
+		m |= unix.S_ISVTX
+	}
+	return m
+}
+
+// renameNoReplace atomically renames file src to dst, failing with error matching os.ErrExist if dst already exists.
+func (d *targetDir) renameNoReplace(src, dst string) error {
+	err := unix.Renameat2(d.fd, src, d.fd, dst, unix.RENAME_NOREPLACE)
+	if errors.Is(err, unix.ENOSYS) || errors.Is(err, unix.EINVAL) { // kernel or file system does not support renameat2 flags, so the file is hard linked under the new name (which fails if it exists) and then unlinked from the old one
+		err = d.linkUnlink(src, dst)
+	}
+	if err != nil {
+		return &os.LinkError{Op: "rename", Old: d.join(src), New: d.join(dst), Err: err}
+	}
+	return nil
+}
+
+// linkUnlink moves file src to dst by hard linking and unlinking it. When the file system does not support hard links, existence of dst is checked before renaming, which is only a best-effort, as dst may be created between the check and the rename.
+func (d *targetDir) linkUnlink(src, dst string) error {
+	err := unix.Linkat(d.fd, src, d.fd, dst, 0)
+	switch {
+	case err == nil:
+		if err := unix.Unlinkat(d.fd, src, 0); err != nil {
+			unix.Unlinkat(d.fd, dst, 0) //nolint:errcheck
+			return err
+		}
+		return nil
+	case errors.Is(err, unix.EEXIST):
+		return err
+	}
+	st := unix.Stat_t{}
+	if err := unix.Fstatat(d.fd, dst, &st, unix.AT_SYMLINK_NOFOLLOW); err == nil {
+		return unix.EEXIST
+	}
+	return unix.Renameat(d.fd, src, d.fd, dst)
+}
+
+// remove removes file with the given name.
+func (d *targetDir) remove(name string) error {
+	if err := unix.Unlinkat(d.fd, name, 0); err != nil {
+		return &os.PathError{Op: "unlinkat", Path: d.join(name), Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+//go:build !linux
+
+package action
+
+import (
+	"errors"
+	"os"
+)
+
+// fileIdentity identifies a file independently of its path.
+type fileIdentity struct {
+	info os.FileInfo
+}
+
+func (id fileIdentity) same(other fileIdentity) bool {
+	return id.info != nil && other.info != nil && os.SameFile(id.info, other.info)
+}
+
+func identifyFile(f *os.File) (fileIdentity, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return fileIdentity{}, err
+	}
+	return fileIdentity{info: info}, nil
+}
+
+func identifyInfo(info os.FileInfo) fileIdentity {
+	return fileIdentity{info: info}
+}
+
+// targetDir is a directory files of an operation are located in. This is a path based implementation, used when the given system is not supported, that relies solely on identity verification (of both the directory and its files) between steps.
+type targetDir struct {
+	path string
+	id   fileIdentity
+}
+
+func openDir(dir string) (*targetDir, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, &os.PathError{Op: "open", Path: dir, Err: errors.New("not a directory")}
+	}
+	return &targetDir{path: dir, id: fileIdentity{info: info}}, nil
+}
+
+func (d *targetDir) close() error {
+	return nil
+}
+
+// identify returns identity of the file with the given name, without following symbolic links.
+func (d *targetDir) identify(name string) (fileIdentity, error) {
+	info, err := os.Lstat(d.join(name))
+	if err != nil {
+		return fileIdentity{}, err
+	}
+	return fileIdentity{info: info}, nil
+}
+
+// openFile opens file with the given name.
+func (d *targetDir) openFile(name string, flag int, perm os.FileMode) (*os.File, error) {
+	return os.OpenFile(d.join(name), flag, perm)
+}
+
+// renameNoReplace renames file src to dst, failing with error matching os.ErrExist if dst already exists. The file is hard linked under the new name (which fails if it exists) and then unlinked from the old one. When the file system does not support hard links, existence of dst is checked before renaming, which is only a best-effort, as dst may be created between the check and the rename.
+func (d *targetDir) renameNoReplace(src, dst string) error {
+	srcPath, dstPath := d.join(src), d.join(dst)
+	err := os.Link(srcPath, dstPath)
+	if err == nil {
+		if err := os.Remove(srcPath); err != nil {
+			os.Remove(dstPath) //nolint:errcheck
+			return err
+		}
+		return nil
+	}
+	if errors.Is(err, os.ErrExist) {
+		return err
+	}
+	if _, err := os.Lstat(dstPath); err == nil {
+		return &os.LinkError{Op: "rename", Old: srcPath, New: dstPath, Err: os.ErrExist}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return os.Rename(srcPath, dstPath)
+}
+
+// remove removes file with the given name.
+func (d *targetDir) remove(name string) error {
+	return os.Remove(d.join(name))
+}
@@ -0,0 +1,205 @@
+package action
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/daishe/impostorcmd/internal/descriptor"
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+const (
+	BuiltinPassthrough = "passthrough" // run the original command unchanged
+	BuiltinDeny        = "deny"        // refuse to run, printing "message" option and exiting with "exit_code" option (126 by default)
+	BuiltinAugment     = "augment"     // run the original command with arguments rewritten by the rewrites of the handler
+)
+
+const defaultDenyExitCode = 126
+
+// interpreter used for script handlers, that do not specify one
+var defaultScriptInterpreter = []string{"/bin/sh", "-c"}
+
+type ErrorUnknownBuiltin struct {
+	Name string
+}
+
+func (e ErrorUnknownBuiltin) Error() string {
+	return fmt.Sprintf("unknown builtin handler %q (must be one of: %s, %s, %s)", e.Name, BuiltinPassthrough, BuiltinDeny, BuiltinAugment)
+}
+
+// ErrorExit is returned by handlers, that finish with the given exit code without running any command.
+type ErrorExit struct {
+	Code int
+}
+
+func (e ErrorExit) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
+
+// ValidateHandler checks whether the given descriptor defines exactly one, well formed handler.
+func ValidateHandler(desc *impostordatav1.TargetDescriptor) error {
+	count := 0
+	if desc.ImpostorCmd != "" {
+		count++
+	}
+	if desc.Builtin != nil {
+		count++
+	}
+	if desc.Script != nil {
+		count++
+	}
+	switch {
+	case count == 0:
+		return fmt.Errorf("no handler (impostor command, builtin or script) defined")
+	case count > 1:
+		return fmt.Errorf("more than one handler (impostor command, builtin or script) defined")
+	}
+
+	if b := desc.Builtin; b != nil {
+		switch b.Name {
+		case BuiltinPassthrough:
+		case BuiltinDeny:
+			if _, err := denyExitCode(b); err != nil {
+				return err
+			}
+		case BuiltinAugment:
+			if err := validateRewrites(b.Rewrites); err != nil {
+				return err
+			}
+		default:
+			return ErrorUnknownBuiltin{Name: b.Name}
+		}
+		if b.Name != BuiltinAugment && len(b.Rewrites) > 0 {
+			return fmt.Errorf("rewrites can only be used with builtin handler %s", BuiltinAugment)
+		}
+	}
+	if s := desc.Script; s != nil && s.Source == "" {
+		return fmt.Errorf("script handler source is empty")
+	}
+	if desc.Builtin != nil && (desc.DeclineExitCode != 0 || desc.DeclineControlFd) {
+		return fmt.Errorf("builtin handler %s cannot decline invocations", desc.Builtin.Name)
+	}
+	if len(desc.ImpostorCmdArgsTemplate) > 0 {
+		if desc.ImpostorCmd == "" {
+			return fmt.Errorf("argument template can only be used with impostor command")
+		}
+		if len(desc.ImpostorCmdArgs) > 0 {
+			return fmt.Errorf("both impostor arguments and argument template defined")
+		}
+		if err := validateArgsTemplate(desc.ImpostorCmdArgsTemplate); err != nil {
+			return err
+		}
+	}
+	if desc.DeclineExitCode > 255 {
+		return fmt.Errorf("invalid decline exit code %d (must be a number between 1 and 255)", desc.DeclineExitCode)
+	}
+	return validateRules(desc)
+}
+
+// CheckHandler validates handler of the given descriptor (see ValidateHandler) and checks whether commands it (and handlers of its rules) runs can be resolved.
+func CheckHandler(desc *impostordatav1.TargetDescriptor) error {
+	if err := ValidateHandler(desc); err != nil {
+		return err
+	}
+	for _, d := range handlerDescriptors(desc) {
+		if cmd := handlerCmd(d); cmd != "" {
+			if _, err := descriptor.Lookup(cmd); err != nil {
+				return fmt.Errorf("cannot resolve handler command %s: %w", cmd, err)
+			}
+		}
+	}
+	return nil
+}
+
+// handlerDescriptors returns the given descriptor followed by descriptors of handlers of all its rules (see ruleDescriptor).
+func handlerDescriptors(desc *impostordatav1.TargetDescriptor) []*impostordatav1.TargetDescriptor {
+	descs := []*impostordatav1.TargetDescriptor{desc}
+	for _, r := range desc.Rules {
+		descs = append(descs, ruleDescriptor(desc, r))
+	}
+	return descs
+}
+
+func denyExitCode(b *impostordatav1.BuiltinHandler) (int, error) {
+	s, ok := b.Options["exit_code"]
+	if !ok {
+		return defaultDenyExitCode, nil
+	}
+	code, err := strconv.Atoi(s)
+	if err != nil || code < 0 || code > 255 {
+		return 0, fmt.Errorf("invalid exit_code option %q of builtin handler %s (must be a number between 0 and 255)", s, b.Name)
+	}
+	return code, nil
+}
+
+// handlerCmd returns command run by handler of the given descriptor, that is impostor command for external handlers and interpreter for script handlers. For builtin handlers, that run no command of their own, empty string is returned.
+func handlerCmd(desc *impostordatav1.TargetDescriptor) string {
+	switch {
+	case desc.Script != nil:
+		return scriptInterpreter(desc.Script)[0]
+	case desc.Builtin != nil:
+		return ""
+	}
+	return desc.ImpostorCmd
+}
+
+func scriptInterpreter(s *impostordatav1.ScriptHandler) []string {
+	if len(s.Interpreter) == 0 {
+		return defaultScriptInterpreter
+	}
+	return s.Interpreter
+}
+
+// handlerArgs returns arguments for the command run by handler of the given descriptor, when the original command was invoked with the given arguments (including argument #0).
+func handlerArgs(desc *impostordatav1.TargetDescriptor, args []string) ([]string, error) {
+	switch {
+	case desc.Script != nil:
+		interpreter := scriptInterpreter(desc.Script)
+		cmdArgs := make([]string, 0, len(interpreter)+len(args))
+		cmdArgs = append(cmdArgs, interpreter[1:]...)
+		cmdArgs = append(cmdArgs, desc.Script.Source)
+		return append(cmdArgs, args...), nil // script receives name of the original command as argument #0
+	case desc.Builtin != nil && desc.Builtin.Name == BuiltinAugment:
+		return rewriteArgs(desc.Builtin.Rewrites, args[1:])
+	case desc.Builtin != nil: // passthrough
+		return append([]string(nil), args[1:]...), nil
+	case len(desc.ImpostorCmdArgsTemplate) > 0:
+		return expandArgsTemplate(desc.ImpostorCmdArgsTemplate, templateValues{args: args, originalCmd: desc.OriginalCmd})
+	}
+	cmdArgs := make([]string, 0, len(desc.GetImpostorCmdArgs())+len(args))
+	cmdArgs = append(cmdArgs, desc.GetImpostorCmdArgs()...)
+	if desc.IncludeArg_0 {
+		return append(cmdArgs, args...), nil
+	}
+	return append(cmdArgs, args[1:]...), nil
+}
+
+// deny runs the deny builtin handler.
+func deny(b *impostordatav1.BuiltinHandler) error {
+	code, err := denyExitCode(b)
+	if err != nil {
+		return err
+	}
+	if msg := b.Options["message"]; msg != "" {
+		fmt.Fprintln(os.Stderr, msg)
+	}
+	return ErrorExit{Code: code}
+}
+
+// handlerEnv returns environment variables for the handler of the given descriptor, running at the given impostor nesting depth.
+func handlerEnv(desc *impostordatav1.TargetDescriptor, depth int) []string {
+	env := append([]string(nil), os.Environ()...)
+	env = append(env, "IMPOSTORCMD_ORIGINAL_COMMAND="+desc.OriginalCmd)
+	env = append(env, recursionEnv(desc, depth)...)
+	names := make([]string, 0, len(desc.Env))
+	for name := range desc.Env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		env = append(env, name+"="+desc.Env[name])
+	}
+	return env
+}
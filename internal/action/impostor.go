@@ -1,12 +1,14 @@
 package action
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"os/signal"
+	"strings"
 	"sync"
 
 	"github.com/daishe/impostorcmd/internal/descriptor"
@@ -24,44 +26,137 @@ func IsCurrentProcessImpostor() (bool, *impostordatav1.TargetDescriptor, error)
 	}
 	defer self.Close()
 
-	desc, err := descriptor.FromExecutable(self)
+	signed, err := descriptor.SignedFromExecutable(self)
 	if err != nil {
 		if !errors.As(err, &descriptor.ErrorNoDescriptor{}) {
 			return false, nil, fmt.Errorf("reading self target descriptor: %w", err)
 		}
 		return false, nil, nil
 	}
-	return true, desc, nil
+	if err := checkSignature(signed); err != nil {
+		return true, nil, fmt.Errorf("verifying self target descriptor: %w", err)
+	}
+	return true, signed.Descriptor, nil
 }
 
 func Impostor(ctx context.Context, target *impostordatav1.TargetDescriptor, args ...string) error {
-	cmdArgs := make([]string, 0, len(target.GetImpostorCmdArgs())+len(args))
-	cmdArgs = append(cmdArgs, target.GetImpostorCmdArgs()...)
-	if target.IncludeArg_0 {
-		cmdArgs = append(cmdArgs, args...)
-	} else {
-		cmdArgs = append(cmdArgs, args[1:]...)
+	if target.VerifyOriginal {
+		if err := quickVerifyOriginal(target); err != nil {
+			return fmt.Errorf("verifying original command: %w", err)
+		}
+	}
+
+	selfPath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("obtaining path to current process executable: %w", err)
+	}
+	if err := ValidateHandler(target); err != nil {
+		return err
+	}
+
+	reentered, depth, err := recursionState(target)
+	if err != nil {
+		return err
+	}
+	if reentered { // handler runs the impostored command, so it gets the original one
+		if err := checkPolicy(selfPath, target, nil); err != nil {
+			return err
+		}
+		return runCmd(ctx, stdCmd(ctx, target.OriginalCmd, args[1:], os.Environ()))
+	}
+
+	handler, err := route(target, args)
+	if err != nil {
+		return err
+	}
+	if err := checkPolicy(selfPath, target, []*impostordatav1.TargetDescriptor{handler}); err != nil { // only the routed handler is run (all of them are checked at install)
+		return err
+	}
+	cmdPath := handler.OriginalCmd // passthrough and augment builtins run the original command
+	pinned := (*os.File)(nil)
+	switch b := handler.Builtin; {
+	case b != nil && b.Name == BuiltinDeny:
+		return deny(b)
+	case b == nil:
+		if cmdPath, pinned, err = resolveImpostor(handler); err != nil {
+			return err
+		}
+	}
+	if pinned != nil {
+		defer pinned.Close()
 	}
 
-	impostorCmdPath, err := descriptor.Lookup(target.ImpostorCmd)
+	control, err := openControl(handler)
+	if err != nil {
+		return fmt.Errorf("creating control file descriptor: %w", err)
+	}
+	if control != nil {
+		defer control.Close()
+	}
+	cmdArgs, err := handlerArgs(handler, args)
 	if err != nil {
 		return err
 	}
+	cmd := stdCmd(ctx, cmdPath, cmdArgs, handlerEnv(handler, depth))
+	withPinned(cmd, pinned)
+	withControl(cmd, handler, control)
+	runErr := runCmd(ctx, cmd)
+	if isDeclined, err := declined(handler, runErr, control); err != nil {
+		return fmt.Errorf("reading control file descriptor: %w", err)
+	} else if isDeclined { // handler left the invocation to the original command (with untouched arguments and standard input)
+		return runCmd(ctx, stdCmd(ctx, target.OriginalCmd, args[1:], os.Environ()))
+	}
+	return runErr
+}
 
-	cmd := exec.CommandContext(ctx, impostorCmdPath, cmdArgs...)
+// stdCmd returns the given command set up to use standard streams of the current process. Number of the control file descriptor inherited from an outer impostor is dropped from the given environment, as the descriptor is not passed on (see withControl).
+func stdCmd(ctx context.Context, cmdPath string, args []string, env []string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, cmdPath, args...)
 	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
-	cmd.Env = append([]string(nil), os.Environ()...)
-	cmd.Env = append(cmd.Env, "IMPOSTORCMD_ORIGINAL_COMMAND="+target.OriginalCmd)
+	cmd.Env = make([]string, 0, len(env))
+	for _, e := range env {
+		if !strings.HasPrefix(e, controlFdEnv+"=") {
+			cmd.Env = append(cmd.Env, e)
+		}
+	}
+	return cmd
+}
+
+// runCmd runs the given command, passing signals to it.
+func runCmd(ctx context.Context, cmd *exec.Cmd) error {
 	if err := cmd.Start(); err != nil {
 		return err
 	}
 
 	sigpassStop := sigpass(ctx, cmd)
-	err = cmd.Wait()
+	err := cmd.Wait()
 	sigpassStop()
 	return err
 }
 
+// resolveImpostor returns path to the command run by handler of the given target (see handlerCmd). For pinned handlers, the command is opened and it is ensured, through the opened file, that it has not changed since install. The opened file is returned to be run (see withPinned), so that exactly the verified file is run.
+func resolveImpostor(target *impostordatav1.TargetDescriptor) (string, *os.File, error) {
+	pin := target.ImpostorPin
+	if pin == nil {
+		path, err := descriptor.Lookup(handlerCmd(target))
+		return path, nil, err
+	}
+	f, err := os.Open(pin.Path)
+	if err != nil {
+		return "", nil, fmt.Errorf("verifying pinned impostor command: %w", err)
+	}
+	fp, err := descriptor.FingerprintFile(f)
+	if err != nil {
+		f.Close()
+		return "", nil, fmt.Errorf("verifying pinned impostor command: %w", err)
+	}
+	if !bytes.Equal(fp.Sha256, pin.Sha256) {
+		f.Close()
+		return "", nil, fmt.Errorf("pinned impostor command %s has changed since install", pin.Path)
+	}
+	return pin.Path, f, nil
+}
+
 func sigpass(ctx context.Context, cmd *exec.Cmd) func() {
 	if ctx == nil {
 		ctx = context.Background()
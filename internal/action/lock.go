@@ -0,0 +1,140 @@
+package action
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const lockPollInterval = 100 * time.Millisecond
+
+type LockOptions struct {
+	Wait    bool          // wait for locks held by other processes to be released instead of failing immediately
+	Timeout time.Duration // maximum time to wait for locks held by other processes (zero means wait indefinitely)
+}
+
+type ErrorLocked struct {
+	Guarded  string // path to the target or directory guarded by the lock
+	Path     string // path to the lock file
+	PID      int    // process ID of the lock holder (zero, when unknown)
+	TimedOut bool   // whether waiting for the lock timed out
+}
+
+func (e ErrorLocked) Error() string {
+	holder := "another process"
+	if e.PID > 0 {
+		holder = fmt.Sprintf("process %d", e.PID)
+	}
+	if e.TimedOut {
+		return fmt.Sprintf("timed out waiting for lock of %s (%s) held by %s", e.Guarded, e.Path, holder)
+	}
+	return fmt.Sprintf("lock of %s (%s) is held by %s", e.Guarded, e.Path, holder)
+}
+
+// ErrorLockingUnsupported is returned by Lock, when cross-process locks are not supported on the current system.
+type ErrorLockingUnsupported struct {
+	OS string
+}
+
+func (e ErrorLockingUnsupported) Error() string {
+	return fmt.Sprintf("locking is not supported on %s", e.OS)
+}
+
+// Unlock releases locks acquired by Lock.
+type Unlock func() error
+
+// Lock acquires advisory, cross-process locks guarding install and uninstall operations on the given targets. For every target both a lock for the target itself and a lock for its directory (the directory where original commands are moved to) are acquired. Lock files are kept in a runtime directory (see lockDir) under names derived from hashes of paths they guard, so that no files are ever created next to targets. Locks are always acquired in the same order, so concurrent invocations cannot deadlock.
+func Lock(targets []string, o LockOptions) (Unlock, error) {
+	dir, err := lockDir()
+	if err != nil {
+		if errors.As(err, &ErrorLockingUnsupported{}) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("preparing lock directory: %w", err)
+	}
+	locks := locksForTargets(dir, targets)
+
+	var deadline time.Time
+	if o.Wait && o.Timeout > 0 {
+		deadline = time.Now().Add(o.Timeout)
+	}
+
+	held := make([]*fileLock, 0, len(locks))
+	unlock := func() error {
+		errs := []error(nil)
+		for i := len(held) - 1; i >= 0; i-- {
+			if err := held[i].unlock(); err != nil {
+				errs = append(errs, fmt.Errorf("releasing lock %s: %w", held[i].path, err))
+			}
+		}
+		held = nil
+		return errors.Join(errs...)
+	}
+
+	for _, spec := range locks {
+		l, err := acquireLock(spec, o.Wait, deadline)
+		if err != nil {
+			if unlockErr := unlock(); unlockErr != nil {
+				return nil, errors.Join(err, unlockErr)
+			}
+			return nil, err
+		}
+		held = append(held, l)
+	}
+	return unlock, nil
+}
+
+// lockSpec names a lock file together with the path it guards.
+type lockSpec struct {
+	guarded string
+	path    string
+}
+
+func locksForTargets(dir string, targets []string) []lockSpec {
+	dirs := map[string]lockSpec{}
+	files := map[string]lockSpec{}
+	for _, t := range targets {
+		d := filepath.Dir(t)
+		dirs[d] = lockSpec{guarded: d, path: filepath.Join(dir, "dir-"+pathHash(d)+".lock")}
+		files[t] = lockSpec{guarded: t, path: filepath.Join(dir, "target-"+pathHash(t)+".lock")}
+	}
+	sorted := func(m map[string]lockSpec) []lockSpec {
+		l := make([]lockSpec, 0, len(m))
+		for _, spec := range m {
+			l = append(l, spec)
+		}
+		sort.Slice(l, func(i, j int) bool { return l[i].guarded < l[j].guarded })
+		return l
+	}
+	// directory locks go first, so that an operation touching a directory is always serialized before locking individual targets
+	return append(sorted(dirs), sorted(files)...)
+}
+
+// pathHash returns hex encoded SHA-256 of the given path.
+func pathHash(path string) string {
+	h := sha256.Sum256([]byte(path))
+	return hex.EncodeToString(h[:])
+}
+
+func acquireLock(spec lockSpec, wait bool, deadline time.Time) (*fileLock, error) {
+	for {
+		l, holderPID, err := tryLockFile(spec.path)
+		if err != nil {
+			return nil, fmt.Errorf("acquiring lock of %s (%s): %w", spec.guarded, spec.path, err)
+		}
+		if l != nil {
+			return l, nil
+		}
+		if !wait {
+			return nil, ErrorLocked{Guarded: spec.guarded, Path: spec.path, PID: holderPID}
+		}
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return nil, ErrorLocked{Guarded: spec.guarded, Path: spec.path, PID: holderPID, TimedOut: true}
+		}
+		time.Sleep(lockPollInterval)
+	}
+}
@@ -0,0 +1,25 @@
+//go:build !(linux || darwin || windows)
+
+package action
+
+import (
+	"runtime"
+)
+
+// lockDir returns directory for lock files. This function is a dummy implementation, that always returns ErrorLockingUnsupported error, as locks are not supported on the given system.
+func lockDir() (string, error) {
+	return "", ErrorLockingUnsupported{OS: runtime.GOOS}
+}
+
+type fileLock struct {
+	path string
+}
+
+// tryLockFile tries to acquire an exclusive advisory lock on the file under the given path. This function is a dummy implementation, that always returns ErrorLockingUnsupported error, as locks are not supported on the given system.
+func tryLockFile(path string) (*fileLock, int, error) {
+	return nil, 0, ErrorLockingUnsupported{OS: runtime.GOOS}
+}
+
+func (l *fileLock) unlock() error {
+	return nil
+}
@@ -0,0 +1,116 @@
+//go:build linux || darwin
+
+package action
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// lockDir returns directory for lock files, creating it when necessary. Privileged users share the system wide runtime directory, others use their own runtime directory ($XDG_RUNTIME_DIR or a directory in the temporary directory, when unset), as they cannot create files in the system wide one. The directory must be owned by the current user and must not be writable by group nor others.
+func lockDir() (string, error) {
+	dir := "/run/impostorcmd"
+	if runtime.GOOS == "darwin" {
+		dir = "/var/run/impostorcmd"
+	}
+	if uid := os.Geteuid(); uid != 0 {
+		if d := os.Getenv("XDG_RUNTIME_DIR"); d != "" {
+			dir = filepath.Join(d, "impostorcmd")
+		} else {
+			dir = filepath.Join(os.TempDir(), "impostorcmd-"+strconv.Itoa(uid))
+		}
+	}
+	if err := os.Mkdir(dir, 0o755); err != nil && !errors.Is(err, os.ErrExist) {
+		return "", err
+	}
+	stat, err := os.Lstat(dir)
+	if err != nil {
+		return "", err
+	}
+	sys, ok := stat.Sys().(*syscall.Stat_t)
+	switch {
+	case !stat.IsDir():
+		return "", fmt.Errorf("%s is not a directory", dir)
+	case !ok || sys == nil || int(sys.Uid) != os.Geteuid():
+		return "", fmt.Errorf("%s must be owned by the current user", dir)
+	case stat.Mode().Perm()&0o022 != 0:
+		return "", fmt.Errorf("%s must not be writable by group nor others", dir)
+	}
+	return dir, nil
+}
+
+type fileLock struct {
+	path string
+	file *os.File
+}
+
+// tryLockFile tries to acquire an exclusive advisory lock on the file under the given path, creating it when necessary. On success the current process ID is recorded in the lock file. When the lock is held by another process, it returns nil lock and process ID of the holder (or zero if it cannot be determined).
+func tryLockFile(path string) (*fileLock, int, error) {
+	for {
+		f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|syscall.O_NOFOLLOW, 0o644)
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+			f.Close()
+			if errors.Is(err, syscall.EWOULDBLOCK) {
+				return nil, readLockHolder(path), nil
+			}
+			return nil, 0, err
+		}
+
+		// lock file may have been removed (and possibly recreated) by the previous holder between open and lock, in which case locking must be retried
+		fStat, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, 0, err
+		}
+		pathStat, err := os.Lstat(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			f.Close()
+			return nil, 0, err
+		}
+		if err != nil || !os.SameFile(fStat, pathStat) {
+			f.Close()
+			continue
+		}
+
+		if err := f.Truncate(0); err != nil {
+			f.Close()
+			return nil, 0, err
+		}
+		if _, err := f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0); err != nil {
+			f.Close()
+			return nil, 0, err
+		}
+		return &fileLock{path: path, file: f}, 0, nil
+	}
+}
+
+func readLockHolder(path string) int {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		return 0
+	}
+	return pid
+}
+
+func (l *fileLock) unlock() error {
+	// remove the lock file while still holding the lock, so that no other process can acquire a lock on a file that is about to disappear (see tryLockFile)
+	removeErr := os.Remove(l.path)
+	closeErr := l.file.Close() // closing releases the lock
+	if removeErr != nil && !errors.Is(removeErr, os.ErrNotExist) {
+		return removeErr
+	}
+	return closeErr
+}
@@ -0,0 +1,86 @@
+//go:build windows
+
+package action
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/windows"
+)
+
+// lockDir returns directory for lock files, creating it when necessary. The directory is shared by all users, so that locks of the same targets taken by different users exclude each other.
+func lockDir() (string, error) {
+	base := os.Getenv("ProgramData")
+	if base == "" {
+		base = `C:\ProgramData`
+	}
+	dir := filepath.Join(base, "impostorcmd", "locks")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// lockedRegionOffset is the offset (far beyond the end of the file) of the byte range locked in lock files, so that process ID recorded at the beginning of the file stays readable by other processes.
+const lockedRegionOffset = 1 << 32
+
+type fileLock struct {
+	path string
+	file *os.File
+}
+
+// tryLockFile tries to acquire an exclusive lock on the file under the given path, creating it when necessary. On success the current process ID is recorded in the lock file. When the lock is held by another process, it returns nil lock and process ID of the holder (or zero if it cannot be determined).
+func tryLockFile(path string) (*fileLock, int, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644) // opened without delete sharing, so the file cannot be removed while open (see unlock)
+	if err != nil {
+		return nil, 0, err
+	}
+	ol := lockedRegion()
+	if err := windows.LockFileEx(windows.Handle(f.Fd()), windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY, 0, 1, 0, ol); err != nil {
+		if errors.Is(err, windows.ERROR_LOCK_VIOLATION) {
+			pid := readLockHolder(f)
+			f.Close()
+			return nil, pid, nil
+		}
+		f.Close()
+		return nil, 0, err
+	}
+
+	if err := f.Truncate(0); err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	if _, err := f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0); err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	return &fileLock{path: path, file: f}, 0, nil
+}
+
+func lockedRegion() *windows.Overlapped {
+	return &windows.Overlapped{Offset: uint32(lockedRegionOffset & 0xffffffff), OffsetHigh: uint32(lockedRegionOffset >> 32)}
+}
+
+func readLockHolder(f *os.File) int {
+	b, err := io.ReadAll(io.LimitReader(f, 32))
+	if err != nil {
+		return 0
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		return 0
+	}
+	return pid
+}
+
+func (l *fileLock) unlock() error {
+	unlockErr := windows.UnlockFileEx(windows.Handle(l.file.Fd()), 0, 1, 0, lockedRegion())
+	closeErr := l.file.Close()
+	os.Remove(l.path) //nolint:errcheck // fails, when another process has just opened the file to lock it, in which case it is left for that process
+	return errors.Join(unlockErr, closeErr)
+}
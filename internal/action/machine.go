@@ -0,0 +1,52 @@
+package action
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/daishe/impostorcmd/internal/config"
+)
+
+// machineConfigDir returns path to the directory containing machine wide configuration files.
+func machineConfigDir() string {
+	return config.SystemConfigDir()
+}
+
+// readMachineConfigFile reads machine wide configuration file with the given name, ensuring that both the file and its directory can only be modified by privileged users. It returns nil when the file does not exist.
+func readMachineConfigFile(name string) ([]byte, error) {
+	dir := machineConfigDir()
+	path := filepath.Join(dir, name)
+
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	defer f.Close()
+
+	dirStat, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	if err := checkPrivilegedOwnership(dir, dirStat); err != nil {
+		return nil, err
+	}
+	fileStat, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	if err := checkPrivilegedOwnership(path, fileStat); err != nil {
+		return nil, err
+	}
+
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return b, nil
+}
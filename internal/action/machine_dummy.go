@@ -0,0 +1,12 @@
+//go:build !(linux || darwin)
+
+package action
+
+import (
+	"os"
+)
+
+// checkPrivilegedOwnership checks whether the given file can only be modified by privileged users. This function is a dummy, no-op implementation, that always return nil error, when the given system is not supported.
+func checkPrivilegedOwnership(path string, stat os.FileInfo) error {
+	return nil
+}
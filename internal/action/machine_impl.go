@@ -0,0 +1,24 @@
+//go:build linux || darwin
+
+package action
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// checkPrivilegedOwnership checks whether the given file is owned by root and is not writable by group nor others.
+func checkPrivilegedOwnership(path string, stat os.FileInfo) error {
+	sys, ok := stat.Sys().(*syscall.Stat_t)
+	if !ok || sys == nil {
+		return fmt.Errorf("cannot determine owner of %s", path)
+	}
+	if sys.Uid != 0 {
+		return fmt.Errorf("%s must be owned by root", path)
+	}
+	if stat.Mode().Perm()&0o022 != 0 {
+		return fmt.Errorf("%s must not be writable by group nor others", path)
+	}
+	return nil
+}
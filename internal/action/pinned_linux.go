@@ -0,0 +1,18 @@
+//go:build linux
+
+package action
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+)
+
+// withPinned makes the given command run the given opened (and verified) pinned impostor command instead of the file under its path, so that the file cannot be swapped between verification and execution. The file is passed to the command as an extra file and run through its /proc/self/fd entry (which keeps working for scripts, as their interpreters read them through the same entry). Argument #0 is left as the path of the pinned command.
+func withPinned(cmd *exec.Cmd, pinned *os.File) {
+	if pinned == nil {
+		return
+	}
+	cmd.ExtraFiles = append(cmd.ExtraFiles, pinned)
+	cmd.Path = "/proc/self/fd/" + strconv.Itoa(2+len(cmd.ExtraFiles)) // extra files follow standard streams
+}
@@ -0,0 +1,12 @@
+//go:build !linux
+
+package action
+
+import (
+	"os"
+	"os/exec"
+)
+
+// withPinned makes the given command run the given opened (and verified) pinned impostor command. This is a path based implementation, used when the given system is not supported, that runs the file under the path of the pinned command, which may be swapped between verification and execution.
+func withPinned(cmd *exec.Cmd, pinned *os.File) {
+}
@@ -0,0 +1,106 @@
+package action
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	configv1 "github.com/daishe/impostorcmd/config/v1"
+	"github.com/daishe/impostorcmd/internal/config"
+	"github.com/daishe/impostorcmd/internal/descriptor"
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+const policyConfigName = "policy.json"
+
+type ErrorPolicyViolation struct {
+	Reason string
+}
+
+func (e ErrorPolicyViolation) Error() string {
+	return fmt.Sprintf("forbidden by machine policy: %s", e.Reason)
+}
+
+// loadPolicy reads machine wide policy. It returns nil, when there is no policy.
+func loadPolicy() (*configv1.Policy, error) {
+	b, err := readMachineConfigFile(policyConfigName)
+	if err != nil || b == nil {
+		return nil, err
+	}
+	return config.UnmarshalAndValidatePolicy(b)
+}
+
+// checkPolicy checks whether impostoring command under the given target path (the path impostor occupies) with impostor described by the given descriptor is allowed by machine wide policy. Original command of the descriptor is checked for setuid and setgid bits and commands run by the given handlers (see handlerDescriptors) are checked against allowed impostors. When there is no policy, no checks are performed.
+func checkPolicy(targetPath string, target *impostordatav1.TargetDescriptor, handlers []*impostordatav1.TargetDescriptor) error {
+	policy, err := loadPolicy()
+	if err != nil {
+		return fmt.Errorf("loading machine policy: %w", err)
+	}
+	if policy == nil {
+		return nil
+	}
+
+	if matched, err := matchAny(policy.DeniedTargets, targetPath); err != nil {
+		return fmt.Errorf("checking denied targets: %w", err)
+	} else if matched {
+		return ErrorPolicyViolation{fmt.Sprintf("target %s is denied", targetPath)}
+	}
+	if len(policy.AllowedTargets) > 0 {
+		if matched, err := matchAny(policy.AllowedTargets, targetPath); err != nil {
+			return fmt.Errorf("checking allowed targets: %w", err)
+		} else if !matched {
+			return ErrorPolicyViolation{fmt.Sprintf("target %s is not allowed", targetPath)}
+		}
+	}
+
+	if !policy.AllowSetuidTargets {
+		stat, err := os.Stat(target.OriginalCmd)
+		if err != nil {
+			return fmt.Errorf("checking original command: %w", err)
+		}
+		if stat.Mode()&(os.ModeSetuid|os.ModeSetgid) != 0 {
+			return ErrorPolicyViolation{fmt.Sprintf("target %s has setuid or setgid bit set", targetPath)}
+		}
+	}
+
+	if len(policy.AllowedImpostors) > 0 {
+		for _, d := range handlers {
+			if err := checkAllowedImpostor(policy, d); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkAllowedImpostor checks whether command run by handler of the given descriptor is allowed by the given policy.
+func checkAllowedImpostor(policy *configv1.Policy, desc *impostordatav1.TargetDescriptor) (err error) {
+	if handlerCmd(desc) == "" { // builtin handlers run no command of their own
+		return nil
+	}
+	impostorPath := ""
+	if desc.ImpostorPin != nil {
+		impostorPath = desc.ImpostorPin.Path
+	} else if impostorPath, err = descriptor.Lookup(handlerCmd(desc)); err != nil {
+		return fmt.Errorf("resolving impostor command: %w", err)
+	}
+	if matched, err := matchAny(policy.AllowedImpostors, impostorPath); err != nil {
+		return fmt.Errorf("checking allowed impostors: %w", err)
+	} else if !matched {
+		return ErrorPolicyViolation{fmt.Sprintf("impostor command %s is not allowed", impostorPath)}
+	}
+	return nil
+}
+
+func matchAny(patterns []string, path string) (bool, error) {
+	for _, p := range patterns {
+		matched, err := filepath.Match(p, path)
+		if err != nil {
+			return false, fmt.Errorf("pattern %q: %w", p, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,23 @@
+//go:build linux
+
+package action
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// parentProcessName returns name of the executable of the parent process. When the executable cannot be read (for example, when the parent belongs to another user), the process name recorded by the kernel (possibly truncated) is returned.
+func parentProcessName() (string, error) {
+	ppid := os.Getppid()
+	if exe, err := os.Readlink(fmt.Sprintf("/proc/%d/exe", ppid)); err == nil {
+		return filepath.Base(strings.TrimSuffix(exe, " (deleted)")), nil
+	}
+	comm, err := os.ReadFile(fmt.Sprintf("/proc/%d/comm", ppid))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(comm)), nil
+}
@@ -0,0 +1,20 @@
+//go:build !linux && !windows
+
+package action
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// parentProcessName returns name of the executable of the parent process, as reported by ps.
+func parentProcessName() (string, error) {
+	out, err := exec.Command("ps", "-o", "comm=", "-p", strconv.Itoa(os.Getppid())).Output()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Base(strings.TrimSpace(string(out))), nil
+}
@@ -0,0 +1,29 @@
+//go:build windows
+
+package action
+
+import (
+	"fmt"
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// parentProcessName returns name of the executable of the parent process, as recorded in the snapshot of running processes.
+func parentProcessName() (string, error) {
+	snapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPPROCESS, 0)
+	if err != nil {
+		return "", err
+	}
+	defer windows.CloseHandle(snapshot) //nolint:errcheck
+
+	ppid := uint32(os.Getppid())
+	entry := windows.ProcessEntry32{Size: uint32(unsafe.Sizeof(windows.ProcessEntry32{}))}
+	for err = windows.Process32First(snapshot, &entry); err == nil; err = windows.Process32Next(snapshot, &entry) {
+		if entry.ProcessID == ppid {
+			return windows.UTF16ToString(entry.ExeFile[:]), nil
+		}
+	}
+	return "", fmt.Errorf("parent process %d not found", ppid)
+}
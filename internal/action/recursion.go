@@ -0,0 +1,57 @@
+package action
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+const (
+	activeEnv = "IMPOSTORCMD_ACTIVE" // original commands of impostors currently running in the process ancestry, separated by the system path list separator
+	depthEnv  = "IMPOSTORCMD_DEPTH"  // number of impostors currently running in the process ancestry
+)
+
+// MaxDepth is the maximal number of impostors, that may run nested within one another.
+const MaxDepth = 32
+
+type ErrorMaxDepth struct {
+	Active []string // original commands of impostors running in the process ancestry
+}
+
+func (e ErrorMaxDepth) Error() string {
+	if len(e.Active) == 0 {
+		return fmt.Sprintf("impostors nested more than %d levels deep", MaxDepth)
+	}
+	return fmt.Sprintf("impostors nested more than %d levels deep (through: %s)", MaxDepth, strings.Join(e.Active, ", "))
+}
+
+// recursionState returns whether the given target is being re-entered from within its own handler (which then should run the original command directly) and the current impostor nesting depth. It returns ErrorMaxDepth, when the maximal nesting depth has been reached.
+func recursionState(target *impostordatav1.TargetDescriptor) (reentered bool, depth int, err error) {
+	active := filepath.SplitList(os.Getenv(activeEnv))
+	for _, a := range active {
+		if a == target.OriginalCmd {
+			return true, 0, nil
+		}
+	}
+	depth, err = strconv.Atoi(os.Getenv(depthEnv))
+	if err != nil || depth < 0 { // unset or mangled counter
+		depth = 0
+	}
+	if depth >= MaxDepth {
+		return false, depth, ErrorMaxDepth{Active: active}
+	}
+	return false, depth, nil
+}
+
+// recursionEnv returns environment variables marking the given target as running at the given nesting depth, for its handler.
+func recursionEnv(target *impostordatav1.TargetDescriptor, depth int) []string {
+	active := target.OriginalCmd
+	if prev := os.Getenv(activeEnv); prev != "" {
+		active = prev + string(os.PathListSeparator) + active
+	}
+	return []string{activeEnv + "=" + active, depthEnv + "=" + strconv.Itoa(depth+1)}
+}
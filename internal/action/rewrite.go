@@ -0,0 +1,128 @@
+package action
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+// validateRewrites checks whether every given argument rewrite is well formed.
+func validateRewrites(rewrites []*impostordatav1.ArgumentRewrite) error {
+	if len(rewrites) == 0 {
+		return fmt.Errorf("builtin handler %s defines no rewrites", BuiltinAugment)
+	}
+	for i, r := range rewrites {
+		if err := validateRewrite(r); err != nil {
+			return fmt.Errorf("rewrite #%d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func validateRewrite(r *impostordatav1.ArgumentRewrite) error {
+	switch k := r.Kind.(type) {
+	case *impostordatav1.ArgumentRewrite_Insert:
+		if len(k.Insert.Args) == 0 {
+			return fmt.Errorf("no arguments to insert")
+		}
+	case *impostordatav1.ArgumentRewrite_Drop:
+		if len(k.Drop.Flags) == 0 {
+			return fmt.Errorf("no flags to drop")
+		}
+		for _, f := range k.Drop.Flags {
+			if !strings.HasPrefix(f, "-") {
+				return fmt.Errorf("flag to drop %q does not start with -", f)
+			}
+		}
+	case *impostordatav1.ArgumentRewrite_Replace:
+		if _, err := regexp.Compile(k.Replace.Regex); err != nil {
+			return fmt.Errorf("invalid regular expression: %w", err)
+		}
+	case *impostordatav1.ArgumentRewrite_RemapSubcommand:
+		if k.RemapSubcommand.From == "" {
+			return fmt.Errorf("no subcommand to remap")
+		}
+	default:
+		return fmt.Errorf("no rewrite defined")
+	}
+	return nil
+}
+
+// rewriteArgs returns the given arguments (without argument #0) rewritten by the given rewrites, applied in order.
+func rewriteArgs(rewrites []*impostordatav1.ArgumentRewrite, args []string) ([]string, error) {
+	args = append([]string(nil), args...)
+	for i, r := range rewrites {
+		switch k := r.Kind.(type) {
+		case *impostordatav1.ArgumentRewrite_Insert:
+			if k.Insert.AtEnd {
+				args = append(args, k.Insert.Args...)
+			} else {
+				args = append(append([]string(nil), k.Insert.Args...), args...)
+			}
+		case *impostordatav1.ArgumentRewrite_Drop:
+			args = dropFlags(args, k.Drop.Flags, k.Drop.WithValue)
+		case *impostordatav1.ArgumentRewrite_Replace:
+			re, err := regexp.Compile(k.Replace.Regex)
+			if err != nil {
+				return nil, fmt.Errorf("rewrite #%d: invalid regular expression: %w", i+1, err)
+			}
+			for j := range args {
+				args[j] = re.ReplaceAllString(args[j], k.Replace.Replacement)
+			}
+		case *impostordatav1.ArgumentRewrite_RemapSubcommand:
+			args = remapSubcommand(args, k.RemapSubcommand.From, k.RemapSubcommand.To)
+		}
+	}
+	return args, nil
+}
+
+// dropFlags returns the given arguments without the given flags (given both as "--flag" and "--flag=value"). When flags take a value, argument following a flag without "=value" is dropped too. Arguments after "--" are left intact.
+func dropFlags(args []string, flags []string, withValue bool) []string {
+	kept := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if a == "--" {
+			return append(kept, args[i:]...)
+		}
+		dropped := false
+		for _, f := range flags {
+			if a == f {
+				dropped = true
+				if withValue {
+					i++ // value of the flag
+				}
+				break
+			}
+			if strings.HasPrefix(a, f+"=") {
+				dropped = true
+				break
+			}
+		}
+		if !dropped {
+			kept = append(kept, a)
+		}
+	}
+	return kept
+}
+
+// remapSubcommand replaces the subcommand (the first argument not starting with "-", unless it follows "--") equal to from with the given arguments.
+func remapSubcommand(args []string, from string, to []string) []string {
+	for i, a := range args {
+		if a == "--" {
+			break
+		}
+		if strings.HasPrefix(a, "-") {
+			continue
+		}
+		if a != from {
+			break
+		}
+		remapped := make([]string, 0, len(args)-1+len(to))
+		remapped = append(remapped, args[:i]...)
+		remapped = append(remapped, to...)
+		return append(remapped, args[i+1:]...)
+	}
+	return args
+}
@@ -0,0 +1,155 @@
+package action
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/term"
+	"google.golang.org/protobuf/proto"
+
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+// ruleDescriptor returns descriptor of the given target with handler (and its pin) replaced by the one of the given rule. Builtin rule handlers never decline.
+func ruleDescriptor(target *impostordatav1.TargetDescriptor, rule *impostordatav1.Rule) *impostordatav1.TargetDescriptor {
+	desc := proto.Clone(target).(*impostordatav1.TargetDescriptor)
+	desc.ImpostorCmd, desc.ImpostorCmdArgs, desc.ImpostorCmdArgsTemplate = rule.ImpostorCmd, rule.ImpostorCmdArgs, rule.ImpostorCmdArgsTemplate
+	desc.Builtin, desc.Script = rule.Builtin, rule.Script
+	desc.ImpostorPin = rule.ImpostorPin
+	desc.Rules = nil
+	if rule.Builtin != nil { // declining applies to handlers running commands only
+		desc.DeclineExitCode, desc.DeclineControlFd = 0, false
+	}
+	return desc
+}
+
+// validateRules checks whether every rule of the given target has a well formed handler and match.
+func validateRules(target *impostordatav1.TargetDescriptor) error {
+	for i, r := range target.Rules {
+		if err := ValidateHandler(ruleDescriptor(target, r)); err != nil {
+			return fmt.Errorf("rule #%d: %w", i+1, err)
+		}
+		if err := validateRuleMatch(r.Match); err != nil {
+			return fmt.Errorf("rule #%d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func validateRuleMatch(m *impostordatav1.RuleMatch) error {
+	if _, err := regexp.Compile(m.GetArgsRegex()); err != nil {
+		return fmt.Errorf("invalid arguments regular expression: %w", err)
+	}
+	for _, p := range append(append([]string(nil), m.GetArgsGlob()...), m.GetParent()...) {
+		if _, err := filepath.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// route returns descriptor of the handler to use for invocation of the given target with the given arguments (including argument #0), that is the target with handler of the first matching rule or the target itself, when no rule matches.
+func route(target *impostordatav1.TargetDescriptor, args []string) (*impostordatav1.TargetDescriptor, error) {
+	for i, r := range target.Rules {
+		matched, err := ruleMatches(r.Match, args)
+		if err != nil {
+			return nil, fmt.Errorf("evaluating rule #%d: %w", i+1, err)
+		}
+		if matched {
+			return ruleDescriptor(target, r), nil
+		}
+	}
+	return target, nil
+}
+
+// ruleMatches checks whether all predicates of the given match hold for the current invocation with the given arguments (including argument #0). Nil match always holds.
+func ruleMatches(m *impostordatav1.RuleMatch, args []string) (bool, error) {
+	if m == nil {
+		return true, nil
+	}
+	if m.ArgsRegex != "" {
+		re, err := regexp.Compile(m.ArgsRegex)
+		if err != nil {
+			return false, err
+		}
+		if !re.MatchString(strings.Join(args[1:], " ")) {
+			return false, nil
+		}
+	}
+	if len(m.ArgsGlob) > len(args)-1 {
+		return false, nil
+	}
+	for i, p := range m.ArgsGlob {
+		if matched, err := filepath.Match(p, args[i+1]); err != nil || !matched {
+			return false, err
+		}
+	}
+	for _, name := range m.EnvSet {
+		if _, ok := os.LookupEnv(name); !ok {
+			return false, nil
+		}
+	}
+	for name, value := range m.EnvEquals {
+		if v, ok := os.LookupEnv(name); !ok || v != value {
+			return false, nil
+		}
+	}
+	if len(m.CwdPrefix) > 0 {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return false, fmt.Errorf("obtaining working directory: %w", err)
+		}
+		if !anyContains(m.CwdPrefix, cwd) {
+			return false, nil
+		}
+	}
+	if len(m.Uid) > 0 && !containsID(m.Uid, os.Getuid()) {
+		return false, nil
+	}
+	if len(m.Gid) > 0 && !containsID(m.Gid, os.Getgid()) {
+		return false, nil
+	}
+	if len(m.Parent) > 0 {
+		name, err := parentProcessName()
+		if err != nil {
+			return false, fmt.Errorf("obtaining parent process name: %w", err)
+		}
+		if matched, err := matchAny(m.Parent, name); err != nil || !matched {
+			return false, err
+		}
+	}
+	if m.StdinTty != nil && isTerminal(os.Stdin) != *m.StdinTty {
+		return false, nil
+	}
+	if m.StdoutTty != nil && isTerminal(os.Stdout) != *m.StdoutTty {
+		return false, nil
+	}
+	return true, nil
+}
+
+// anyContains checks whether any of the given directories contains (or is) the given path.
+func anyContains(dirs []string, path string) bool {
+	for _, d := range dirs {
+		rel, err := filepath.Rel(filepath.Clean(d), path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsID(ids []uint32, id int) bool {
+	for _, v := range ids {
+		if id >= 0 && v == uint32(id) { // -1 on systems without user and group IDs
+			return true
+		}
+	}
+	return false
+}
+
+func isTerminal(f *os.File) bool {
+	return term.IsTerminal(int(f.Fd()))
+}
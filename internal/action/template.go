@@ -0,0 +1,116 @@
+package action
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type ErrorInvalidTemplate struct {
+	Template string
+	Reason   string
+}
+
+func (e ErrorInvalidTemplate) Error() string {
+	return fmt.Sprintf("invalid argument template %q: %s", e.Template, e.Reason)
+}
+
+// templateValues provides values of placeholders of argument templates for a single invocation.
+type templateValues struct {
+	args        []string // arguments of the original command (including argument #0)
+	originalCmd string
+}
+
+// lookup returns value of the placeholder with the given name. Values of {target_name} and {cwd} are obtained only when used.
+func (v templateValues) lookup(name string) (string, error) {
+	switch {
+	case name == "args":
+		return strings.Join(v.args[1:], " "), nil
+	case name == "arg0":
+		return v.args[0], nil
+	case strings.HasPrefix(name, "arg:"):
+		n, err := strconv.Atoi(strings.TrimPrefix(name, "arg:"))
+		if err != nil || n < 0 {
+			return "", fmt.Errorf("invalid argument number in placeholder {%s}", name)
+		}
+		if n >= len(v.args) { // missing arguments are empty
+			return "", nil
+		}
+		return v.args[n], nil
+	case name == "original_cmd":
+		return v.originalCmd, nil
+	case name == "target_name":
+		self, err := os.Executable()
+		if err != nil {
+			return "", fmt.Errorf("obtaining path to current process executable: %w", err)
+		}
+		return filepath.Base(self), nil
+	case name == "cwd":
+		return os.Getwd()
+	}
+	return "", fmt.Errorf("unknown placeholder {%s}", name)
+}
+
+// validateArgsTemplate checks whether every element of the given argument template is well formed and uses only known placeholders.
+func validateArgsTemplate(tmpl []string) error {
+	v := templateValues{args: []string{""}}
+	for _, t := range tmpl {
+		_, err := expandTemplate(t, func(name string) (string, error) {
+			if name == "target_name" || name == "cwd" { // known, but not worth obtaining
+				return "", nil
+			}
+			return v.lookup(name)
+		})
+		if err != nil {
+			return ErrorInvalidTemplate{Template: t, Reason: err.Error()}
+		}
+	}
+	return nil
+}
+
+// expandArgsTemplate returns arguments built from the given template. Template element consisting of just {args} expands to all arguments of the original command (without argument #0) as separate arguments, while anywhere else {args} stands for those arguments joined with spaces.
+func expandArgsTemplate(tmpl []string, v templateValues) ([]string, error) {
+	expanded := make([]string, 0, len(tmpl)+len(v.args))
+	for _, t := range tmpl {
+		if t == "{args}" {
+			expanded = append(expanded, v.args[1:]...)
+			continue
+		}
+		e, err := expandTemplate(t, v.lookup)
+		if err != nil {
+			return nil, ErrorInvalidTemplate{Template: t, Reason: err.Error()}
+		}
+		expanded = append(expanded, e)
+	}
+	return expanded, nil
+}
+
+// expandTemplate replaces every placeholder in the given template element with its value. Sequence {{ stands for a literal { and } outside of a placeholder is left as is.
+func expandTemplate(t string, lookup func(name string) (string, error)) (string, error) {
+	b := strings.Builder{}
+	for {
+		i := strings.IndexByte(t, '{')
+		if i == -1 {
+			b.WriteString(t)
+			return b.String(), nil
+		}
+		b.WriteString(t[:i])
+		if strings.HasPrefix(t[i:], "{{") {
+			b.WriteByte('{')
+			t = t[i+2:]
+			continue
+		}
+		end := strings.IndexByte(t[i:], '}')
+		if end == -1 {
+			return "", fmt.Errorf("unterminated placeholder %q", t[i:])
+		}
+		value, err := lookup(t[i+1 : i+end])
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(value)
+		t = t[i+end+1:]
+	}
+}
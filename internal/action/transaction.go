@@ -0,0 +1,107 @@
+package action
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Transaction records actions taken, so that all of them can be either committed or rolled back. Every action is recorded as a named step, that may have an undo function (run on rollback) and a commit function (run on commit, for finalizing actions that cannot be undone, like file removal). Resources needed by steps are released after all steps, whether the transaction is committed or rolled back.
+type Transaction struct {
+	steps    []transactionStep
+	releases []transactionStep
+}
+
+type transactionStep struct {
+	name   string
+	undo   func() error
+	commit func() error
+}
+
+// Step records an action with the given name, that can be undone with the given function.
+func (t *Transaction) Step(name string, undo func() error) {
+	t.steps = append(t.steps, transactionStep{name: name, undo: undo})
+}
+
+// OnCommit records a finalizing action with the given name, that will be run on commit.
+func (t *Transaction) OnCommit(name string, commit func() error) {
+	t.steps = append(t.steps, transactionStep{name: name, commit: commit})
+}
+
+// Release records a function with the given name, that releases resources needed by steps. Release functions are run in reverse order, after all steps are either committed or rolled back.
+func (t *Transaction) Release(name string, release func() error) {
+	t.releases = append(t.releases, transactionStep{name: name, commit: release})
+}
+
+// Include records all steps of other transaction as a single step with the given name.
+func (t *Transaction) Include(name string, other *Transaction) {
+	if other == nil {
+		other = &Transaction{}
+	}
+	t.steps = append(t.steps, transactionStep{
+		name:   name,
+		undo:   func() error { return other.Rollback().Err() },
+		commit: func() error { return other.Commit().Err() },
+	})
+}
+
+// Rollback attempts to undo every recorded step in reverse order. Failure to undo a step does not stop undoing remaining steps. Outcome of every step is reported.
+func (t *Transaction) Rollback() Report {
+	if t == nil {
+		return nil
+	}
+	r := make(Report, 0, len(t.steps))
+	for i := len(t.steps) - 1; i >= 0; i-- {
+		s := t.steps[i]
+		if s.undo == nil {
+			continue
+		}
+		r = append(r, StepResult{Name: s.name, Err: s.undo()})
+	}
+	t.steps = nil
+	return append(r, t.release()...)
+}
+
+// Commit runs commit function of every recorded step in order. Failure of a commit function does not stop running remaining ones. Outcome of every step is reported.
+func (t *Transaction) Commit() Report {
+	if t == nil {
+		return nil
+	}
+	r := make(Report, 0, len(t.steps))
+	for _, s := range t.steps {
+		if s.commit == nil {
+			continue
+		}
+		r = append(r, StepResult{Name: s.name, Err: s.commit()})
+	}
+	t.steps = nil
+	return append(r, t.release()...)
+}
+
+func (t *Transaction) release() Report {
+	r := make(Report, 0, len(t.releases))
+	for i := len(t.releases) - 1; i >= 0; i-- {
+		r = append(r, StepResult{Name: t.releases[i].name, Err: t.releases[i].commit()})
+	}
+	t.releases = nil
+	return r
+}
+
+// StepResult is an outcome of undoing or committing a single transaction step.
+type StepResult struct {
+	Name string
+	Err  error
+}
+
+// Report lists outcomes of undoing or committing transaction steps.
+type Report []StepResult
+
+// Err joins errors of all failed steps (or returns nil if all succeeded).
+func (r Report) Err() error {
+	errs := []error(nil)
+	for _, s := range r {
+		if s.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", s.Name, s.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
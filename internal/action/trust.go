@@ -0,0 +1,66 @@
+package action
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"os"
+
+	configv1 "github.com/daishe/impostorcmd/config/v1"
+	"github.com/daishe/impostorcmd/internal/config"
+	"github.com/daishe/impostorcmd/internal/descriptor"
+)
+
+const trustConfigName = "trust.json"
+
+// loadTrust reads machine wide trust configuration. It returns nil, when there is no trust configuration.
+func loadTrust() (*configv1.Trust, []ed25519.PublicKey, error) {
+	b, err := readMachineConfigFile(trustConfigName)
+	if err != nil || b == nil {
+		return nil, nil, err
+	}
+	trust, err := config.UnmarshalAndValidateTrust(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]ed25519.PublicKey, 0, len(trust.TrustedKeys))
+	for i, k := range trust.TrustedKeys {
+		key, err := descriptor.ParsePublicKey(k)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing trusted key #%d: %w", i+1, err)
+		}
+		keys = append(keys, key)
+	}
+	return trust, keys, nil
+}
+
+// checkSignature checks signature of the given descriptor against trusted keys from machine wide trust configuration. When there is no trust configuration, no checks are performed.
+func checkSignature(s *descriptor.Signed) error {
+	trust, keys, err := loadTrust()
+	if err != nil {
+		return fmt.Errorf("loading trust configuration: %w", err)
+	}
+	if trust == nil {
+		return nil
+	}
+	if s.Signature == nil {
+		return applySignaturePolicy(trust.MissingSignature, fmt.Errorf("impostor descriptor is not signed"))
+	}
+	if err := s.Verify(); err != nil {
+		return applySignaturePolicy(trust.InvalidSignature, err)
+	}
+	if !s.SignedBy(keys) {
+		return applySignaturePolicy(trust.InvalidSignature, descriptor.ErrorSignatureInvalid{Reason: "signed with untrusted key"})
+	}
+	return nil
+}
+
+func applySignaturePolicy(p configv1.SignaturePolicy, problem error) error {
+	switch p {
+	case configv1.SignaturePolicy_SIGNATURE_POLICY_ALLOW:
+		return nil
+	case configv1.SignaturePolicy_SIGNATURE_POLICY_WARN:
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", problem)
+		return nil
+	}
+	return problem // fail closed
+}
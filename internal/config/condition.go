@@ -0,0 +1,58 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	configv2 "github.com/daishe/impostorcmd/config/v2"
+)
+
+// EvaluateCondition checks whether the given condition is met on the current machine. When it is not, a human readable reason is returned. Nil condition is always met.
+func EvaluateCondition(c *configv2.Condition) (met bool, reason string, err error) {
+	for _, cmd := range c.GetCommandExists() {
+		if _, err := exec.LookPath(cmd); err != nil {
+			return false, fmt.Sprintf("command %s does not exist", cmd), nil
+		}
+	}
+	for _, path := range c.GetFileExists() {
+		if _, err := os.Stat(path); err != nil {
+			return false, fmt.Sprintf("file %s does not exist", path), nil
+		}
+	}
+	if patterns := c.GetHostname(); len(patterns) > 0 {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return false, "", fmt.Errorf("obtaining hostname: %w", err)
+		}
+		matched := false
+		for _, p := range patterns {
+			m, err := filepath.Match(p, hostname)
+			if err != nil {
+				return false, "", fmt.Errorf("invalid hostname pattern %s: %w", p, err)
+			}
+			matched = matched || m
+		}
+		if !matched {
+			return false, fmt.Sprintf("hostname %s does not match any of: %s", hostname, strings.Join(patterns, ", ")), nil
+		}
+	}
+	if archs := c.GetArch(); len(archs) > 0 {
+		matched := false
+		for _, a := range archs {
+			matched = matched || a == runtime.GOARCH
+		}
+		if !matched {
+			return false, fmt.Sprintf("architecture %s is none of: %s", runtime.GOARCH, strings.Join(archs, ", ")), nil
+		}
+	}
+	for _, name := range c.GetEnvSet() {
+		if _, ok := os.LookupEnv(name); !ok {
+			return false, fmt.Sprintf("environment variable %s is not set", name), nil
+		}
+	}
+	return true, "", nil
+}
@@ -8,28 +8,38 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	configv1 "github.com/daishe/impostorcmd/config/v1"
+	configv2 "github.com/daishe/impostorcmd/config/v2"
 )
 
 type VersionEntity interface {
 	GetVersion() string
 }
 
-func checkStrictVersionString(v string) error {
+// supported versions of configuration (and targets), the latest one comes last
+var configurationVersions = []string{"v1", "v2"}
+
+func checkStrictVersionString(v string, supported ...string) error {
+	if len(supported) == 0 {
+		supported = []string{"v1"}
+	}
 	if strings.IndexFunc(v, unicode.IsSpace) != -1 {
 		return fmt.Errorf("version cannot contain whitespace characters")
 	} else if v == "" {
 		return fmt.Errorf("unset version is unsupported")
-	} else if v != "v1" {
-		return fmt.Errorf("version %s is unsupported", v)
 	}
-	return nil
+	for _, s := range supported {
+		if v == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("version %s is unsupported", v)
 }
 
-func checkRelaxedVersionString(v string) error {
+func checkRelaxedVersionString(v string, supported ...string) error {
 	if v == "" { // allow empty version
 		return nil
 	}
-	return checkStrictVersionString(v)
+	return checkStrictVersionString(v, supported...)
 }
 
 func UnmarshalAndValidateVersionEntity(p []byte) (VersionEntity, error) {
@@ -44,29 +54,104 @@ func ValidateVersionEntity(ve VersionEntity) error {
 	return checkStrictVersionString(ve.GetVersion())
 }
 
-func UnmarshalAndValidateTarget(targetBytes []byte) (*configv1.Target, error) {
-	if _, err := UnmarshalAndValidateVersionEntity(targetBytes); err != nil {
+// UnmarshalAndValidateTarget parses JSON description of a single target of any supported version. Targets of older versions are converted to the latest one.
+func UnmarshalAndValidateTarget(targetBytes []byte) (*configv2.Target, error) {
+	ve := &configv2.VersionEntity{}
+	if err := (protojson.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true}).Unmarshal(targetBytes, ve); err != nil {
+		return nil, fmt.Errorf("unmarshalling target information: parsing version: %w", err)
+	}
+	if err := checkStrictVersionString(ve.Version, configurationVersions...); err != nil {
 		return nil, fmt.Errorf("unmarshalling target information: %w", err)
 	}
-	target := &configv1.Target{}
+	if ve.Version == "v1" {
+		target := &configv1.Target{}
+		if err := (protojson.UnmarshalOptions{AllowPartial: false, DiscardUnknown: false}).Unmarshal(targetBytes, target); err != nil {
+			return nil, fmt.Errorf("unmarshalling target information: %w", err)
+		}
+		return MigrateTargetV1(target), nil
+	}
+	target := &configv2.Target{}
 	if err := (protojson.UnmarshalOptions{AllowPartial: false, DiscardUnknown: false}).Unmarshal(targetBytes, target); err != nil {
 		return nil, fmt.Errorf("unmarshalling target information: %w", err)
 	}
 	return target, nil
 }
 
-func UnmarshalAndValidateConfiguration(cfgBytes []byte) (*configv1.Config, error) {
-	if _, err := UnmarshalAndValidateVersionEntity(cfgBytes); err != nil {
+// UnmarshalAndValidateConfiguration parses configuration of any supported version in the given format. Configurations of older versions are converted to the latest one.
+func UnmarshalAndValidateConfiguration(cfgBytes []byte, format Format) (*configv2.Config, error) {
+	version, err := unmarshalConfigurationVersion(cfgBytes, format)
+	if err != nil {
+		return nil, err
+	}
+	if version == "v1" {
+		cfg, err := UnmarshalAndValidateConfigurationV1(cfgBytes, format)
+		if err != nil {
+			return nil, err
+		}
+		return MigrateV1(cfg), nil
+	}
+	cfg := &configv2.Config{}
+	if err := unmarshal(cfgBytes, format, cfg, false); err != nil {
 		return nil, fmt.Errorf("unmarshalling configuration: %w", err)
 	}
+	for i, t := range cfg.Targets {
+		if err := checkRelaxedVersionString(t.GetVersion(), version); err != nil {
+			return nil, fmt.Errorf("unmarshalling configuration: target #%d (%s): %w", i+1, t.Cmd, err)
+		}
+	}
+	return cfg, nil
+}
+
+// UnmarshalAndValidateConfigurationV1 parses configuration in the given format, that must be of version v1.
+func UnmarshalAndValidateConfigurationV1(cfgBytes []byte, format Format) (*configv1.Config, error) {
+	version, err := unmarshalConfigurationVersion(cfgBytes, format)
+	if err != nil {
+		return nil, err
+	}
+	if version != "v1" {
+		return nil, fmt.Errorf("unmarshalling configuration: expected version v1, got %s", version)
+	}
 	cfg := &configv1.Config{}
-	if err := (protojson.UnmarshalOptions{AllowPartial: false, DiscardUnknown: false}).Unmarshal(cfgBytes, cfg); err != nil {
+	if err := unmarshal(cfgBytes, format, cfg, false); err != nil {
 		return nil, fmt.Errorf("unmarshalling configuration: %w", err)
 	}
 	for i, t := range cfg.Targets {
-		if err := checkRelaxedVersionString(t.GetVersion()); err != nil {
+		if err := checkRelaxedVersionString(t.GetVersion(), version); err != nil {
 			return nil, fmt.Errorf("unmarshalling configuration: target #%d (%s): %w", i+1, t.Cmd, err)
 		}
 	}
 	return cfg, nil
 }
+
+func unmarshalConfigurationVersion(cfgBytes []byte, format Format) (string, error) {
+	ve := &configv2.VersionEntity{}
+	if err := unmarshal(cfgBytes, format, ve, true); err != nil {
+		return "", fmt.Errorf("unmarshalling configuration: parsing version: %w", err)
+	}
+	if err := checkStrictVersionString(ve.Version, configurationVersions...); err != nil {
+		return "", fmt.Errorf("unmarshalling configuration: %w", err)
+	}
+	return ve.Version, nil
+}
+
+func UnmarshalAndValidateTrust(trustBytes []byte) (*configv1.Trust, error) {
+	if _, err := UnmarshalAndValidateVersionEntity(trustBytes); err != nil {
+		return nil, fmt.Errorf("unmarshalling trust configuration: %w", err)
+	}
+	trust := &configv1.Trust{}
+	if err := (protojson.UnmarshalOptions{AllowPartial: false, DiscardUnknown: false}).Unmarshal(trustBytes, trust); err != nil {
+		return nil, fmt.Errorf("unmarshalling trust configuration: %w", err)
+	}
+	return trust, nil
+}
+
+func UnmarshalAndValidatePolicy(policyBytes []byte) (*configv1.Policy, error) {
+	if _, err := UnmarshalAndValidateVersionEntity(policyBytes); err != nil {
+		return nil, fmt.Errorf("unmarshalling policy: %w", err)
+	}
+	policy := &configv1.Policy{}
+	if err := (protojson.UnmarshalOptions{AllowPartial: false, DiscardUnknown: false}).Unmarshal(policyBytes, policy); err != nil {
+		return nil, fmt.Errorf("unmarshalling policy: %w", err)
+	}
+	return policy, nil
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ConfigEnv is the environment variable, that (when set) lists configuration files and directories to use instead of the discovered ones.
+const ConfigEnv = "IMPOSTORCMD_CONFIG"
+
+const (
+	defaultConfigName      = "config"   // name (without extension) of configuration file looked up in configuration directories
+	defaultFragmentDirName = "config.d" // name of directory of configuration fragments looked up in configuration directories
+)
+
+type ErrorAmbiguousConfiguration struct {
+	Paths []string
+}
+
+func (e ErrorAmbiguousConfiguration) Error() string {
+	return fmt.Sprintf("more than one configuration file found, keep only one of: %s", strings.Join(e.Paths, ", "))
+}
+
+// UserConfigDir returns path to the directory containing configuration files of the current user, that is impostorcmd directory within $XDG_CONFIG_HOME (or its system specific default).
+func UserConfigDir() (string, error) {
+	base, err := userConfigBaseDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "impostorcmd"), nil
+}
+
+// DefaultSources returns configuration files and directories making up the default configuration, in merge order. When ConfigEnv environment variable is set, paths listed in it (separated by the system path list separator) are returned as they are. Otherwise, machine wide configuration directory (see SystemConfigDir) and then user configuration directory (see UserConfigDir) are searched for config file (with any known configuration format extension, e.g. config.yaml) and config.d directory of configuration fragments, in that order. Returned slice is empty, when there is no default configuration.
+func DefaultSources() ([]string, error) {
+	if env := os.Getenv(ConfigEnv); env != "" {
+		sources := []string(nil)
+		for _, p := range filepath.SplitList(env) {
+			if p != "" {
+				sources = append(sources, p)
+			}
+		}
+		return sources, nil
+	}
+
+	dirs := []string{SystemConfigDir()}
+	if d, err := UserConfigDir(); err == nil { // without home directory there simply is no user configuration
+		dirs = append(dirs, d)
+	}
+	sources := []string(nil)
+	for _, d := range dirs {
+		found, err := configDirSources(d)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, found...)
+	}
+	return sources, nil
+}
+
+// configDirSources returns configuration file and directory of configuration fragments found in the given configuration directory.
+func configDirSources(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir) // entries are sorted by file name
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading configuration directory: %w", err)
+	}
+	files := []string(nil)
+	fragments := ""
+	for _, e := range entries {
+		path := filepath.Join(dir, e.Name())
+		if e.Name() == defaultFragmentDirName {
+			if info, err := os.Stat(path); err == nil && info.IsDir() { // follows symbolic links
+				fragments = path
+			}
+			continue
+		}
+		if _, ok := formatByExtension(e.Name()); ok && strings.TrimSuffix(e.Name(), filepath.Ext(e.Name())) == defaultConfigName {
+			files = append(files, path)
+		}
+	}
+	if len(files) > 1 {
+		return nil, ErrorAmbiguousConfiguration{Paths: files}
+	}
+	if fragments != "" {
+		files = append(files, fragments)
+	}
+	return files, nil
+}
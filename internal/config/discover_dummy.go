@@ -0,0 +1,24 @@
+//go:build !(linux || darwin)
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// SystemConfigDir returns path to the directory containing machine wide configuration files.
+func SystemConfigDir() string {
+	if d := os.Getenv("ProgramData"); d != "" {
+		return filepath.Join(d, "impostorcmd")
+	}
+	return filepath.Join(`C:\ProgramData`, "impostorcmd")
+}
+
+// userConfigBaseDir returns base directory for user configuration files, that is $XDG_CONFIG_HOME or the system specific one (for example %AppData%), when unset.
+func userConfigBaseDir() (string, error) {
+	if d := os.Getenv("XDG_CONFIG_HOME"); d != "" {
+		return d, nil
+	}
+	return os.UserConfigDir()
+}
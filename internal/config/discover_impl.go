@@ -0,0 +1,25 @@
+//go:build linux || darwin
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// SystemConfigDir returns path to the directory containing machine wide configuration files.
+func SystemConfigDir() string {
+	return "/etc/impostorcmd"
+}
+
+// userConfigBaseDir returns base directory for user configuration files, that is $XDG_CONFIG_HOME or ~/.config, when unset.
+func userConfigBaseDir() (string, error) {
+	if d := os.Getenv("XDG_CONFIG_HOME"); d != "" {
+		return d, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config"), nil
+}
@@ -0,0 +1,213 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	configv2 "github.com/daishe/impostorcmd/config/v2"
+)
+
+type ErrorUndefinedVariable struct {
+	Name string
+}
+
+func (e ErrorUndefinedVariable) Error() string {
+	if name, isEnv := strings.CutPrefix(e.Name, "env:"); isEnv {
+		return fmt.Sprintf("environment variable %s is not set", name)
+	}
+	return fmt.Sprintf("variable %s is undefined", e.Name)
+}
+
+// Variables describes variables available for expansion in target fields. Besides user-defined variables, HOME (home directory of the current user), config_dir (directory of the configuration file, if any) and env:NAME (environment variable NAME) are available.
+type Variables struct {
+	ConfigDir string            // directory of the configuration file (empty, when there is no configuration file)
+	Vars      map[string]string // user-defined variables (already expanded)
+}
+
+func isBuiltinVariable(name string) bool {
+	return name == "HOME" || name == "config_dir" || strings.HasPrefix(name, "env:")
+}
+
+func (v Variables) lookup(name string) (string, error) {
+	switch {
+	case name == "HOME":
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("variable HOME: %w", err)
+		}
+		return home, nil
+	case name == "config_dir":
+		if v.ConfigDir == "" {
+			return "", ErrorUndefinedVariable{Name: name}
+		}
+		return v.ConfigDir, nil
+	case strings.HasPrefix(name, "env:"):
+		if value, ok := os.LookupEnv(strings.TrimPrefix(name, "env:")); ok {
+			return value, nil
+		}
+		return "", ErrorUndefinedVariable{Name: name}
+	}
+	if value, ok := v.Vars[name]; ok {
+		return value, nil
+	}
+	return "", ErrorUndefinedVariable{Name: name}
+}
+
+// Expand replaces every ${name} in the given string with value of the variable. Sequence $$ stands for a literal $ and any other $ is left as is.
+func (v Variables) Expand(s string) (string, error) {
+	b := strings.Builder{}
+	for {
+		i := strings.IndexByte(s, '$')
+		if i == -1 || i == len(s)-1 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+		b.WriteString(s[:i])
+		switch s[i+1] {
+		case '$':
+			b.WriteByte('$')
+			s = s[i+2:]
+		case '{':
+			end := strings.IndexByte(s[i+2:], '}')
+			if end == -1 {
+				return "", fmt.Errorf("unterminated variable reference in %q", s[i:])
+			}
+			value, err := v.lookup(s[i+2 : i+2+end])
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(value)
+			s = s[i+2+end+1:]
+		default:
+			b.WriteByte('$')
+			s = s[i+1:]
+		}
+	}
+}
+
+// With returns variables extended with the given user-defined variables, whose values are expanded first. User-defined variables override ones with the same name, but cannot redefine built-in variables.
+func (v Variables) With(vars map[string]string) (Variables, error) {
+	merged := make(map[string]string, len(v.Vars)+len(vars))
+	for name, value := range v.Vars {
+		merged[name] = value
+	}
+	builtin := Variables{ConfigDir: v.ConfigDir} // values cannot refer to other user-defined variables, so that the order of definition does not matter
+	for name, value := range vars {
+		if name == "" || isBuiltinVariable(name) || strings.ContainsAny(name, "${}") {
+			return Variables{}, fmt.Errorf("invalid user-defined variable name %q", name)
+		}
+		expanded, err := builtin.Expand(value)
+		if err != nil {
+			return Variables{}, fmt.Errorf("variable %s: %w", name, err)
+		}
+		merged[name] = expanded
+	}
+	return Variables{ConfigDir: v.ConfigDir, Vars: merged}, nil
+}
+
+// ExpandTarget expands variables in command, external handler command and arguments, script handler interpreter, rules (see expandRule) and environment variable values of the given target in place. Script sources are left intact, as they usually use the same syntax for their own variables.
+func ExpandTarget(t *configv2.Target, v Variables) (err error) {
+	if t.Cmd, err = v.Expand(t.Cmd); err != nil {
+		return fmt.Errorf("expanding cmd: %w", err)
+	}
+	if err := expandHandler(t.Handler, v); err != nil {
+		return err
+	}
+	for i, r := range t.Rules {
+		if err := expandRule(r, v); err != nil {
+			return fmt.Errorf("rule #%d: %w", i+1, err)
+		}
+	}
+	for name, value := range t.GetRuntime().GetEnv() {
+		if t.Runtime.Env[name], err = v.Expand(value); err != nil {
+			return fmt.Errorf("expanding environment variable %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func expandHandler(h *configv2.Handler, v Variables) (err error) {
+	if e := h.GetExternal(); e != nil {
+		if e.Cmd, err = v.Expand(e.Cmd); err != nil {
+			return fmt.Errorf("expanding handler command: %w", err)
+		}
+		for i := range e.Args {
+			if e.Args[i], err = v.Expand(e.Args[i]); err != nil {
+				return fmt.Errorf("expanding handler argument #%d: %w", i+1, err)
+			}
+		}
+		for i := range e.ArgsTemplate {
+			if e.ArgsTemplate[i], err = v.Expand(e.ArgsTemplate[i]); err != nil {
+				return fmt.Errorf("expanding handler argument template #%d: %w", i+1, err)
+			}
+		}
+	}
+	for i, r := range h.GetBuiltin().GetRewrites() {
+		if err := expandRewrite(r, v); err != nil {
+			return fmt.Errorf("expanding handler rewrite #%d: %w", i+1, err)
+		}
+	}
+	if s := h.GetScript(); s != nil {
+		for i := range s.Interpreter {
+			if s.Interpreter[i], err = v.Expand(s.Interpreter[i]); err != nil {
+				return fmt.Errorf("expanding script interpreter: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// expandRewrite expands variables in inserted arguments and subcommand replacements of the given rewrite in place. Patterns are left as they are.
+func expandRewrite(r *configv2.ArgumentRewrite, v Variables) (err error) {
+	args := r.GetInsert().GetArgs()
+	if to := r.GetRemapSubcommand().GetTo(); to != nil {
+		args = to
+	}
+	for i := range args {
+		if args[i], err = v.Expand(args[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expandRule expands variables in handler, working directories and environment variable values of the given rule in place. Patterns are left as they are.
+func expandRule(r *configv2.Rule, v Variables) (err error) {
+	if err := expandHandler(r.Handler, v); err != nil {
+		return err
+	}
+	m := r.GetMatch()
+	if m == nil {
+		return nil
+	}
+	for i := range m.CwdPrefix {
+		if m.CwdPrefix[i], err = v.Expand(m.CwdPrefix[i]); err != nil {
+			return fmt.Errorf("expanding working directory: %w", err)
+		}
+	}
+	for name, value := range m.EnvEquals {
+		if m.EnvEquals[name], err = v.Expand(value); err != nil {
+			return fmt.Errorf("expanding value of environment variable %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// ExpandCondition expands variables in commands and paths of files of the given condition in place.
+func ExpandCondition(c *configv2.Condition, v Variables) (err error) {
+	if c == nil {
+		return nil
+	}
+	for i := range c.CommandExists {
+		if c.CommandExists[i], err = v.Expand(c.CommandExists[i]); err != nil {
+			return fmt.Errorf("expanding condition command: %w", err)
+		}
+	}
+	for i := range c.FileExists {
+		if c.FileExists[i], err = v.Expand(c.FileExists[i]); err != nil {
+			return fmt.Errorf("expanding condition file: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+type Format string
+
+const (
+	FormatJSON      Format = "json"
+	FormatYAML      Format = "yaml"
+	FormatTOML      Format = "toml"
+	FormatTextproto Format = "textproto"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatJSON, FormatYAML, FormatTOML, FormatTextproto:
+		return f, nil
+	case "yml":
+		return FormatYAML, nil
+	}
+	return "", fmt.Errorf("unknown configuration format %q (must be one of: json, yaml, toml, textproto)", s)
+}
+
+// FormatFromPath detects configuration format by file extension. It defaults to JSON.
+func FormatFromPath(path string) Format {
+	if f, ok := formatByExtension(path); ok {
+		return f
+	}
+	return FormatJSON
+}
+
+// formatByExtension detects configuration format by file extension, reporting whether the extension is a known one.
+func formatByExtension(path string) (Format, bool) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON, true
+	case ".yaml", ".yml":
+		return FormatYAML, true
+	case ".toml":
+		return FormatTOML, true
+	case ".textproto", ".txtpb", ".pbtxt":
+		return FormatTextproto, true
+	}
+	return "", false
+}
+
+// unmarshal parses the given bytes in the given format into the given message. YAML and TOML documents are converted to JSON and parsed with protojson, so that all formats (except protobuf text format) share field naming and validation rules.
+func unmarshal(b []byte, format Format, m proto.Message, discardUnknown bool) error {
+	switch format {
+	case FormatTextproto:
+		return (prototext.UnmarshalOptions{AllowPartial: false, DiscardUnknown: discardUnknown}).Unmarshal(b, m)
+	case FormatYAML, FormatTOML:
+		jsonBytes, err := toJSON(b, format)
+		if err != nil {
+			return err
+		}
+		b = jsonBytes
+	case FormatJSON:
+	default:
+		return fmt.Errorf("unknown configuration format %q", format)
+	}
+	return (protojson.UnmarshalOptions{AllowPartial: false, DiscardUnknown: discardUnknown}).Unmarshal(b, m)
+}
+
+// marshal formats the given message in the given format. Field names are the protobuf ones and fields are ordered as in the message definition.
+func marshal(m proto.Message, format Format) ([]byte, error) {
+	if format == FormatTextproto {
+		return (prototext.MarshalOptions{Multiline: true, Indent: "  "}).Marshal(m)
+	}
+	jsonBytes, err := (protojson.MarshalOptions{UseProtoNames: true}).Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	switch format {
+	case FormatJSON:
+		if err := json.Indent(buf, jsonBytes, "", "  "); err != nil { // protojson output is deliberately unstable in whitespace
+			return nil, err
+		}
+		buf.WriteByte('\n')
+	case FormatYAML:
+		node, err := yamlNode(jsonBytes)
+		if err != nil {
+			return nil, err
+		}
+		if err := encodeYAML(buf, node); err != nil {
+			return nil, err
+		}
+	case FormatTOML:
+		v := map[string]interface{}{}
+		if err := json.Unmarshal(jsonBytes, &v); err != nil {
+			return nil, err
+		}
+		if err := toml.NewEncoder(buf).Encode(v); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown configuration format %q", format)
+	}
+	return buf.Bytes(), nil
+}
+
+// yamlNode converts the given protojson output to YAML document node, preserving order of fields.
+func yamlNode(jsonBytes []byte) (*yaml.Node, error) {
+	node := &yaml.Node{}
+	if err := yaml.Unmarshal(jsonBytes, node); err != nil { // unmarshalling into a node preserves order of fields
+		return nil, err
+	}
+	clearYAMLStyle(node)
+	return node, nil
+}
+
+func encodeYAML(w io.Writer, node *yaml.Node) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// clearYAMLStyle resets style of the given node and all its descendants, so that they are encoded in block style with plain scalars, wherever possible.
+func clearYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		clearYAMLStyle(n)
+	}
+}
+
+func toJSON(b []byte, format Format) ([]byte, error) {
+	v := map[string]interface{}{}
+	switch format {
+	case FormatYAML:
+		if err := yaml.Unmarshal(b, &v); err != nil {
+			return nil, fmt.Errorf("parsing YAML: %w", err)
+		}
+	case FormatTOML:
+		if err := toml.Unmarshal(b, &v); err != nil {
+			return nil, fmt.Errorf("parsing TOML: %w", err)
+		}
+	}
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("converting %s to JSON: %w", format, err)
+	}
+	return jsonBytes, nil
+}
@@ -0,0 +1,323 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	configv2 "github.com/daishe/impostorcmd/config/v2"
+	"github.com/daishe/impostorcmd/internal/descriptor"
+)
+
+// Location identifies a target within configuration files.
+type Location struct {
+	Path  string // absolute path of the configuration file
+	Index int    // zero based index of the target within the configuration file
+}
+
+func (l Location) String() string {
+	return fmt.Sprintf("%s (target #%d)", l.Path, l.Index+1)
+}
+
+type LoadedTarget struct {
+	Target     *configv2.Target
+	Location   Location
+	SkipReason string // why the target is not to be installed, because its conditions are not met (empty, when they are)
+	ExpandErr  error  // why fields of the target could not be expanded, in which case the target is left unexpanded (set only for targets, whose conditions are not met)
+}
+
+// Loaded is a configuration merged from all configuration files.
+type Loaded struct {
+	Targets  []*LoadedTarget
+	Profiles map[string]*configv2.Profile
+}
+
+type ErrorUnknownProfile struct {
+	Name  string
+	Known []string
+}
+
+func (e ErrorUnknownProfile) Error() string {
+	if len(e.Known) == 0 {
+		return fmt.Sprintf("unknown profile %s (configuration defines no profiles)", e.Name)
+	}
+	return fmt.Sprintf("unknown profile %s (must be one of: %s)", e.Name, strings.Join(e.Known, ", "))
+}
+
+// Select returns targets belonging to any of the given profiles or having any of the given tags. When neither profiles nor tags are given, all targets are returned.
+func (l *Loaded) Select(profiles []string, tags []string) ([]*LoadedTarget, error) {
+	if len(profiles) == 0 && len(tags) == 0 {
+		return l.Targets, nil
+	}
+	selectedTags := map[string]bool{}
+	selectedCmds := map[string]bool{}
+	for _, t := range tags {
+		selectedTags[t] = true
+	}
+	for _, name := range profiles {
+		p, ok := l.Profiles[name]
+		if !ok {
+			known := make([]string, 0, len(l.Profiles))
+			for n := range l.Profiles {
+				known = append(known, n)
+			}
+			sort.Strings(known)
+			return nil, ErrorUnknownProfile{Name: name, Known: known}
+		}
+		for _, t := range p.Tags {
+			selectedTags[t] = true
+		}
+		for _, c := range p.Cmds {
+			selectedCmds[c] = true
+		}
+	}
+
+	selected := []*LoadedTarget(nil)
+	for _, t := range l.Targets {
+		isSelected := selectedCmds[t.Target.Cmd]
+		for _, tag := range t.Target.Tags {
+			isSelected = isSelected || selectedTags[tag]
+		}
+		if isSelected {
+			selected = append(selected, t)
+		}
+	}
+	return selected, nil
+}
+
+type ErrorDuplicateTarget struct {
+	Cmd    string   // resolved path of the command (or the command as written, when it does not exist)
+	First  Location // location of the target naming the command first
+	Second Location // location of the target naming the command once more
+}
+
+func (e ErrorDuplicateTarget) Error() string {
+	return fmt.Sprintf("command %s targeted more than once: in %s and in %s", e.Cmd, e.First, e.Second)
+}
+
+type ErrorIncludeCycle struct {
+	Chain []string // paths of configuration files forming the cycle, starting and ending with the same file
+}
+
+func (e ErrorIncludeCycle) Error() string {
+	return fmt.Sprintf("configuration include cycle: %s", strings.Join(e.Chain, " -> "))
+}
+
+// Load reads configuration from the given paths, following includes, and returns all targets (with variables expanded) together with their locations. Configurations from all paths are merged in the given order, as if the first one included all the others. Conditions of targets are evaluated and targets, whose conditions are not met, are marked as such. Variables defined in a configuration file are available in files it includes. Each path may point to a configuration file or a directory, in which case all configuration fragments (files with a known configuration format extension) within it are merged in lexical order. Empty format means detection by file extension. A configuration file included more than once is merged only once, but a command named by more than one target (see checkDuplicateTargets) results in ErrorDuplicateTarget error (for every such command).
+func Load(paths []string, format Format) (*Loaded, error) {
+	loaded, err := load(paths, format, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDuplicateTargets(loaded.Targets); err != nil {
+		return nil, err
+	}
+	return loaded, nil
+}
+
+// load reads configuration as Load does, but without checking for duplicate targets. If the check function is given, it is called for every configuration file before unmarshalling, and files for which it returns false are skipped.
+func load(paths []string, format Format, check func(path string, cfgBytes []byte, format Format) bool) (*Loaded, error) {
+	l := &loader{loaded: map[string]bool{}, profiles: map[string]*configv2.Profile{}, check: check}
+	for _, path := range paths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return nil, fmt.Errorf("resolving configuration path: %w", err)
+		}
+		info, err := os.Stat(absPath)
+		if err != nil {
+			return nil, fmt.Errorf("reading configuration: %w", err)
+		}
+
+		if info.IsDir() {
+			if format != "" {
+				return nil, fmt.Errorf("configuration format cannot be specified for configuration directory %s", absPath)
+			}
+			err = l.loadDir(absPath, Variables{})
+		} else {
+			fileFormat := format
+			if fileFormat == "" {
+				fileFormat = FormatFromPath(absPath)
+			}
+			err = l.loadFile(absPath, fileFormat, Variables{})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &Loaded{Targets: l.targets, Profiles: l.profiles}, nil
+}
+
+type loader struct {
+	targets   []*LoadedTarget
+	profiles  map[string]*configv2.Profile
+	loaded    map[string]bool // configuration files already merged
+	including []string        // chain of configuration files currently being loaded (used to detect include cycles)
+	check     func(path string, cfgBytes []byte, format Format) bool
+}
+
+func (l *loader) loadFile(path string, format Format, inherited Variables) error {
+	for i, p := range l.including {
+		if p == path {
+			chain := append(append([]string{}, l.including[i:]...), path)
+			return ErrorIncludeCycle{Chain: chain}
+		}
+	}
+	if l.loaded[path] {
+		return nil
+	}
+	l.loaded[path] = true
+
+	cfgBytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading configuration file: %w", err)
+	}
+	if l.check != nil && !l.check(path, cfgBytes, format) {
+		return nil
+	}
+	cfg, err := UnmarshalAndValidateConfiguration(cfgBytes, format)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	vars, err := (Variables{ConfigDir: filepath.Dir(path), Vars: inherited.Vars}).With(cfg.Vars)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for name, p := range cfg.Profiles {
+		if err := l.mergeProfile(name, p, vars); err != nil {
+			return fmt.Errorf("%s: profile %s: %w", path, name, err)
+		}
+	}
+	for i, t := range cfg.Targets {
+		loc := Location{Path: path, Index: i}
+		if err := ExpandCondition(t.When, vars); err != nil {
+			return fmt.Errorf("%s: %w", loc, err)
+		}
+		met, reason, err := EvaluateCondition(t.When)
+		if err != nil {
+			return fmt.Errorf("%s: evaluating condition: %w", loc, err)
+		}
+		if !met { // targets, that are not to be installed, are still needed expanded to uninstall or inspect them, but they may refer to variables that exist only when conditions are met
+			expanded := proto.Clone(t).(*configv2.Target)
+			if err := ExpandTarget(expanded, vars); err != nil {
+				l.targets = append(l.targets, &LoadedTarget{Target: t, Location: loc, SkipReason: reason, ExpandErr: err})
+				continue
+			}
+			l.targets = append(l.targets, &LoadedTarget{Target: expanded, Location: loc, SkipReason: reason})
+			continue
+		}
+		if err := ExpandTarget(t, vars); err != nil {
+			return fmt.Errorf("%s: %w", loc, err)
+		}
+		l.targets = append(l.targets, &LoadedTarget{Target: t, Location: loc})
+	}
+
+	l.including = append(l.including, path)
+	defer func() { l.including = l.including[:len(l.including)-1] }()
+	for _, include := range cfg.Includes {
+		if err := l.loadInclude(filepath.Dir(path), include, vars); err != nil {
+			return fmt.Errorf("%s: include %s: %w", path, include, err)
+		}
+	}
+	return nil
+}
+
+func (l *loader) mergeProfile(name string, p *configv2.Profile, vars Variables) error {
+	merged, ok := l.profiles[name]
+	if !ok {
+		merged = &configv2.Profile{}
+		l.profiles[name] = merged
+	}
+	merged.Tags = append(merged.Tags, p.Tags...)
+	for _, c := range p.Cmds {
+		c, err := vars.Expand(c)
+		if err != nil {
+			return err
+		}
+		merged.Cmds = append(merged.Cmds, c)
+	}
+	if merged.Description == "" {
+		merged.Description = p.Description
+	}
+	return nil
+}
+
+func (l *loader) loadDir(dir string, inherited Variables) error {
+	entries, err := os.ReadDir(dir) // entries are sorted by file name
+	if err != nil {
+		return fmt.Errorf("reading configuration directory: %w", err)
+	}
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		format, ok := formatByExtension(e.Name())
+		if !ok {
+			continue
+		}
+		if err := l.loadFile(filepath.Join(dir, e.Name()), format, inherited); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *loader) loadInclude(baseDir string, include string, inherited Variables) error {
+	pattern := include
+	if !filepath.IsAbs(pattern) {
+		pattern = filepath.Join(baseDir, pattern)
+	}
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return err
+	}
+	if len(matches) == 0 && !strings.ContainsAny(include, "*?[") { // glob pattern matching nothing is fine, but a plain path must exist
+		_, err := os.Stat(pattern)
+		if err == nil {
+			err = os.ErrNotExist
+		}
+		return err
+	}
+	for _, m := range matches {
+		info, err := os.Stat(m)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			err = l.loadDir(m, inherited)
+		} else {
+			err = l.loadFile(m, FormatFromPath(m), inherited)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkDuplicateTargets reports every command named by more than one target, whose conditions are met. Commands are compared by their resolved paths (after expanding glob patterns), so that different ways of naming the same command (for example a name looked up in PATH and an absolute path, or a glob pattern and a plain path) are reported as well. Targets naming no existing command are compared as written.
+func checkDuplicateTargets(targets []*LoadedTarget) error {
+	first := map[string]Location{}
+	errs := []error(nil)
+	for _, t := range targets {
+		if t.SkipReason != "" { // alternative definitions of the same target guarded by mutually exclusive conditions are fine
+			continue
+		}
+		cmds, err := descriptor.Cmds(t.Target)
+		if err != nil { // reported, when the target is used
+			cmds = []string{t.Target.Cmd}
+		}
+		for _, cmd := range cmds {
+			if loc, ok := first[cmd]; ok {
+				errs = append(errs, ErrorDuplicateTarget{Cmd: cmd, First: loc, Second: t.Location})
+				continue
+			}
+			first[cmd] = t.Location
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,201 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	configv1 "github.com/daishe/impostorcmd/config/v1"
+	configv2 "github.com/daishe/impostorcmd/config/v2"
+)
+
+// MigrateV1 converts the given v1 configuration to v2. Conversion is lossless, every v1 field has its v2 counterpart.
+func MigrateV1(cfg *configv1.Config) *configv2.Config {
+	migrated := &configv2.Config{
+		Version:  "v2",
+		Includes: cfg.GetIncludes(),
+		Vars:     cfg.GetVars(),
+	}
+	for _, t := range cfg.GetTargets() {
+		migrated.Targets = append(migrated.Targets, MigrateTargetV1(t))
+	}
+	if len(cfg.GetProfiles()) > 0 {
+		migrated.Profiles = make(map[string]*configv2.Profile, len(cfg.GetProfiles()))
+		for name, p := range cfg.GetProfiles() {
+			migrated.Profiles[name] = &configv2.Profile{Tags: p.GetTags(), Cmds: p.GetCmds(), Description: p.GetDescription()}
+		}
+	}
+	return migrated
+}
+
+// MigrateTargetV1 converts the given v1 target to v2. Impostor command and its arguments become an external handler.
+func MigrateTargetV1(t *configv1.Target) *configv2.Target {
+	migrated := &configv2.Target{
+		Cmd:         t.GetCmd(),
+		AllInPath:   t.GetAllInPath(),
+		Optional:    t.GetOptional(),
+		Tags:        t.GetTags(),
+		Description: t.GetDescription(),
+		Owner:       t.GetOwner(),
+	}
+	if t.GetVersion() != "" {
+		migrated.Version = "v2"
+	}
+	if t.GetImpostor() != "" || len(t.GetImpostorArgs()) > 0 {
+		migrated.Handler = &configv2.Handler{Kind: &configv2.Handler_External{External: &configv2.ExternalHandler{
+			Cmd:  t.GetImpostor(),
+			Args: t.GetImpostorArgs(),
+		}}}
+	}
+	if t.GetIncludeArg_0() || t.GetVerifyOriginal() {
+		migrated.Runtime = &configv2.RuntimeOptions{IncludeArg_0: t.GetIncludeArg_0(), VerifyOriginal: t.GetVerifyOriginal()}
+	}
+	if c := t.GetWhen(); c != nil {
+		migrated.When = &configv2.Condition{
+			CommandExists: c.GetCommandExists(),
+			FileExists:    c.GetFileExists(),
+			Hostname:      c.GetHostname(),
+			Arch:          c.GetArch(),
+			EnvSet:        c.GetEnvSet(),
+		}
+	}
+	return migrated
+}
+
+// Migrate rewrites configuration of version v1 given in the given format to the latest version in the output format. The rewritten configuration is checked to load to exactly the same configuration as the original one. When both formats are YAML, the document is rewritten in place, preserving comments and order of fields.
+func Migrate(cfgBytes []byte, format Format, outputFormat Format) ([]byte, error) {
+	version, err := unmarshalConfigurationVersion(cfgBytes, format)
+	if err != nil {
+		return nil, err
+	}
+	if latest := configurationVersions[len(configurationVersions)-1]; version == latest {
+		return nil, fmt.Errorf("configuration already is of the latest version %s", latest)
+	}
+	cfg, err := UnmarshalAndValidateConfigurationV1(cfgBytes, format)
+	if err != nil {
+		return nil, err
+	}
+	expected := MigrateV1(cfg)
+
+	migrated := []byte(nil)
+	if format == FormatYAML && outputFormat == FormatYAML {
+		migrated, err = migrateYAML(cfgBytes)
+	} else {
+		migrated, err = marshal(expected, outputFormat)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("rewriting configuration: %w", err)
+	}
+
+	got, err := UnmarshalAndValidateConfiguration(migrated, outputFormat)
+	if err != nil {
+		return nil, fmt.Errorf("checking rewritten configuration: %w", err)
+	}
+	if !proto.Equal(got, expected) {
+		return nil, fmt.Errorf("checking rewritten configuration: it differs from the original one")
+	}
+	return migrated, nil
+}
+
+func migrateYAML(cfgBytes []byte) ([]byte, error) {
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(cfgBytes, doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) != 1 {
+		return nil, fmt.Errorf("expected a single YAML document")
+	}
+	root := resolveAlias(doc.Content[0])
+	if version := mappingValue(root, "version"); version != nil {
+		version.Value = "v2"
+	}
+	if targets := mappingValue(root, "targets"); targets != nil && targets.Kind == yaml.SequenceNode {
+		migratedNodes := map[*yaml.Node]bool{} // targets may be shared through anchors and aliases
+		for _, t := range targets.Content {
+			t = resolveAlias(t)
+			if t.Kind == yaml.MappingNode && !migratedNodes[t] {
+				migratedNodes[t] = true
+				migrateYAMLTarget(t)
+			}
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// migrateYAMLTarget rewrites the given v1 target node to v2 in place. Impostor command and arguments are moved to external handler and include_arg_0 and verify_original to runtime options, at the position of the first moved field. Moved fields with zero values (false, empty or null) are dropped.
+func migrateYAMLTarget(t *yaml.Node) {
+	external := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Style: t.Style & yaml.FlowStyle}
+	runtime := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Style: t.Style & yaml.FlowStyle}
+	content := make([]*yaml.Node, 0, len(t.Content))
+	insertAt := -1
+	for i := 0; i+1 < len(t.Content); i += 2 {
+		key, value := t.Content[i], t.Content[i+1]
+		switch key.Value {
+		case "version":
+			if value.Value == "v1" {
+				value.Value = "v2"
+			}
+			content = append(content, key, value)
+			continue
+		case "impostor", "impostor_args", "impostorArgs":
+			if !isZeroYAML(value) { // unset fields are dropped, as MigrateTargetV1 sets no handler for them
+				renamed := *key
+				renamed.Value = map[string]string{"impostor": "cmd", "impostor_args": "args", "impostorArgs": "args"}[key.Value]
+				external.Content = append(external.Content, &renamed, value)
+			}
+		case "include_arg_0", "includeArg0", "verify_original", "verifyOriginal":
+			if !isZeroYAML(value) { // unset fields are dropped, as MigrateTargetV1 sets no runtime options for them
+				runtime.Content = append(runtime.Content, key, value)
+			}
+		default:
+			content = append(content, key, value)
+			continue
+		}
+		if insertAt == -1 {
+			insertAt = len(content)
+		}
+	}
+	if insertAt == -1 {
+		return
+	}
+
+	moved := []*yaml.Node(nil)
+	if len(external.Content) > 0 {
+		handler := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Style: t.Style & yaml.FlowStyle}
+		handler.Content = []*yaml.Node{{Kind: yaml.ScalarNode, Tag: "!!str", Value: "external"}, external}
+		moved = append(moved, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "handler"}, handler)
+	}
+	if len(runtime.Content) > 0 {
+		moved = append(moved, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "runtime"}, runtime)
+	}
+	t.Content = append(content[:insertAt:insertAt], append(moved, content[insertAt:]...)...)
+}
+
+// isZeroYAML reports whether the given node holds zero value of a field: null, false, empty string or empty list.
+func isZeroYAML(node *yaml.Node) bool {
+	node = resolveAlias(node)
+	switch node.Kind {
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!null":
+			return true
+		case "!!bool":
+			b := false
+			return node.Decode(&b) == nil && !b
+		case "!!str":
+			return node.Value == ""
+		}
+	case yaml.SequenceNode:
+		return len(node.Content) == 0
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMigrateYAMLDropsZeroFields(t *testing.T) {
+	cfg := `version: v1
+targets:
+  - cmd: gcc
+    impostor: ccache
+    include_arg_0: false
+    verify_original: false
+  - cmd: make
+    impostor: remake
+    impostor_args: []
+  - cmd: ld
+    impostor: ""
+    impostor_args: [--wrap]
+    include_arg_0: true
+`
+	migrated, err := Migrate([]byte(cfg), FormatYAML, FormatYAML)
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	for _, unexpected := range []string{"include_arg_0: false", "verify_original: false", "args: []", `cmd: ""`} {
+		if strings.Contains(string(migrated), unexpected) {
+			t.Errorf("Migrate() result contains %q:\n%s", unexpected, migrated)
+		}
+	}
+	if !strings.Contains(string(migrated), "include_arg_0: true") {
+		t.Errorf("Migrate() result lost include_arg_0:\n%s", migrated)
+	}
+}
@@ -0,0 +1,119 @@
+package config
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	configv1 "github.com/daishe/impostorcmd/config/v1"
+	configv2 "github.com/daishe/impostorcmd/config/v2"
+)
+
+// JSONSchema returns JSON Schema (draft 2020-12) of configuration files of the given version (the latest one, when empty), generated from protobuf descriptors of configuration messages. Fields are described under both their protobuf and JSON names (when those differ), as both are accepted.
+func JSONSchema(version string) ([]byte, error) {
+	if version == "" {
+		version = configurationVersions[len(configurationVersions)-1]
+	}
+	if err := checkStrictVersionString(version, configurationVersions...); err != nil {
+		return nil, err
+	}
+	root := (&configv2.Config{}).ProtoReflect().Descriptor()
+	if version == "v1" {
+		root = (&configv1.Config{}).ProtoReflect().Descriptor()
+	}
+	g := &schemaGenerator{root: root, version: version, defs: map[string]interface{}{}}
+	g.addMessageSchema(root)
+
+	schema := map[string]interface{}{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$id":     "https://github.com/daishe/impostorcmd/config/" + version + "/config.schema.json",
+		"title":   "impostorcmd configuration " + version,
+		"$ref":    schemaRef(root),
+		"$defs":   g.defs,
+	}
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+type schemaGenerator struct {
+	root    protoreflect.MessageDescriptor // root configuration message
+	version string                         // version of the configuration
+	defs    map[string]interface{}         // schemas of messages, by their full names
+}
+
+func schemaRef(md protoreflect.MessageDescriptor) string {
+	return "#/$defs/" + string(md.FullName())
+}
+
+func (g *schemaGenerator) addMessageSchema(md protoreflect.MessageDescriptor) {
+	name := string(md.FullName())
+	if _, ok := g.defs[name]; ok {
+		return
+	}
+	properties := map[string]interface{}{}
+	s := map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	g.defs[name] = s
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		fs := g.fieldSchema(fd)
+		if fd.Name() == "version" {
+			fs = g.versionSchema(md)
+		}
+		properties[string(fd.Name())] = fs
+		if fd.JSONName() != string(fd.Name()) {
+			properties[fd.JSONName()] = fs
+		}
+	}
+	if md.FullName() == g.root.FullName() {
+		s["required"] = []string{"version"}
+	}
+	if oneofs := md.Oneofs(); oneofs.Len() == 1 && oneofs.Get(0).Fields().Len() == fields.Len() { // message is just a choice between its fields
+		s["maxProperties"] = 1
+	}
+}
+
+func (g *schemaGenerator) versionSchema(md protoreflect.MessageDescriptor) map[string]interface{} {
+	if md.FullName() == g.root.FullName() {
+		return map[string]interface{}{"type": "string", "enum": []string{g.version}}
+	}
+	return map[string]interface{}{"type": "string", "enum": []string{"", g.version}} // nested objects may omit version
+}
+
+func (g *schemaGenerator) fieldSchema(fd protoreflect.FieldDescriptor) map[string]interface{} {
+	switch {
+	case fd.IsMap():
+		return map[string]interface{}{"type": "object", "additionalProperties": g.valueSchema(fd.MapValue())}
+	case fd.IsList():
+		return map[string]interface{}{"type": "array", "items": g.valueSchema(fd)}
+	}
+	return g.valueSchema(fd)
+}
+
+func (g *schemaGenerator) valueSchema(fd protoreflect.FieldDescriptor) map[string]interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		g.addMessageSchema(fd.Message())
+		return map[string]interface{}{"$ref": schemaRef(fd.Message())}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return map[string]interface{}{"type": []string{"string", "integer"}, "enum": names}
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return map[string]interface{}{"type": "string"}
+	case protoreflect.BoolKind:
+		return map[string]interface{}{"type": "boolean"}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return map[string]interface{}{"type": "number"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]interface{}{"type": []string{"integer", "string"}} // 64-bit integers may be given as strings
+	}
+	return map[string]interface{}{"type": "integer"}
+}
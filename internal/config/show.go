@@ -0,0 +1,54 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+
+	configv2 "github.com/daishe/impostorcmd/config/v2"
+)
+
+// Show formats the given loaded configuration as a single YAML configuration of the latest version, with variables expanded and includes resolved. Each target is annotated with a comment telling where it came from and whether it is skipped. The given sources are listed in the header comment.
+func Show(loaded *Loaded, sources []string) ([]byte, error) {
+	cfg := &configv2.Config{Version: configurationVersions[len(configurationVersions)-1], Profiles: loaded.Profiles}
+	for _, t := range loaded.Targets {
+		cfg.Targets = append(cfg.Targets, t.Target)
+	}
+	jsonBytes, err := (protojson.MarshalOptions{UseProtoNames: true}).Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := yamlNode(jsonBytes)
+	if err != nil {
+		return nil, err
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) != 1 {
+		return nil, fmt.Errorf("expected a single YAML document")
+	}
+
+	header := &strings.Builder{}
+	header.WriteString("Effective configuration merged from (in order):")
+	for _, s := range sources {
+		header.WriteString("\n  " + s)
+	}
+	doc.HeadComment = header.String()
+	if targets := mappingValue(doc.Content[0], "targets"); targets != nil {
+		for i, node := range targets.Content {
+			t := loaded.Targets[i]
+			comment := "from " + t.Location.String()
+			if t.SkipReason != "" {
+				comment += "\nskipped: condition not met: " + t.SkipReason
+			}
+			node.HeadComment = comment
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := encodeYAML(buf, doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
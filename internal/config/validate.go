@@ -0,0 +1,311 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+
+	configv1 "github.com/daishe/impostorcmd/config/v1"
+	configv2 "github.com/daishe/impostorcmd/config/v2"
+)
+
+// Diagnostic describes a single problem found in configuration.
+type Diagnostic struct {
+	Path    string // path of the configuration file (empty, when unknown)
+	Line    int    // line number, starting from 1 (0, when unknown)
+	Column  int    // column number, starting from 1 (0, when unknown)
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	switch {
+	case d.Path == "":
+		return d.Message
+	case d.Line == 0:
+		return fmt.Sprintf("%s: %s", d.Path, d.Message)
+	case d.Column == 0:
+		return fmt.Sprintf("%s:%d: %s", d.Path, d.Line, d.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", d.Path, d.Line, d.Column, d.Message)
+}
+
+type validator struct {
+	diagnostics []Diagnostic
+	trees       map[string]*yaml.Node // parsed YAML and JSON configuration files, used to locate targets
+	version     string                // version of the configuration file being checked
+}
+
+// Validate checks configuration under the given path (following includes, as Load does) and returns all problems found. Structural problems (syntax errors, unknown fields, values of wrong type, unsupported versions) are reported with line and column, whenever the configuration format allows it. Then duplicate targets are reported and the given function is used to check every target, whose conditions are met.
+func Validate(path string, format Format, checkTarget func(*configv2.Target) error) []Diagnostic {
+	v := &validator{trees: map[string]*yaml.Node{}}
+	loaded, err := load([]string{path}, format, v.checkFile)
+	if err != nil {
+		v.diagnostics = append(v.diagnostics, Diagnostic{Message: err.Error()})
+		return v.diagnostics
+	}
+
+	for _, err := range unwrapJoined(checkDuplicateTargets(loaded.Targets)) {
+		dup := ErrorDuplicateTarget{}
+		if errors.As(err, &dup) {
+			v.addAtTarget(dup.Second, err.Error())
+		} else {
+			v.diagnostics = append(v.diagnostics, Diagnostic{Message: err.Error()})
+		}
+	}
+	for _, t := range loaded.Targets {
+		if t.SkipReason != "" {
+			continue
+		}
+		if err := checkTarget(t.Target); err != nil {
+			for _, err := range unwrapJoined(err) {
+				v.addAtTarget(t.Location, fmt.Sprintf("target %s: %v", t.Target.Cmd, err))
+			}
+		}
+	}
+	return v.diagnostics
+}
+
+func unwrapJoined(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
+
+func (v *validator) add(path string, node *yaml.Node, format string, args ...interface{}) {
+	d := Diagnostic{Path: path, Message: fmt.Sprintf(format, args...)}
+	if node != nil {
+		d.Line, d.Column = node.Line, node.Column
+	}
+	v.diagnostics = append(v.diagnostics, d)
+}
+
+func (v *validator) addAtTarget(loc Location, message string) {
+	v.add(loc.Path, v.targetNode(loc), "%s", message)
+}
+
+// targetNode returns node of the target under the given location or nil, if it cannot be found.
+func (v *validator) targetNode(loc Location) *yaml.Node {
+	root := v.trees[loc.Path]
+	if root == nil {
+		return nil
+	}
+	targets := mappingValue(root, "targets")
+	if targets == nil || targets.Kind != yaml.SequenceNode || loc.Index >= len(targets.Content) {
+		return nil
+	}
+	return targets.Content[loc.Index]
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return resolveAlias(node.Content[i+1])
+		}
+	}
+	return nil
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	return node
+}
+
+var errorPositionRegexp = regexp.MustCompile(`line (\d+)(?::(\d+))?`)
+
+// checkFile checks structure of the given configuration file, reporting whether it is valid.
+func (v *validator) checkFile(path string, cfgBytes []byte, format Format) bool {
+	count := len(v.diagnostics)
+	if format == FormatJSON || format == FormatYAML { // YAML is a superset of JSON, so both can be checked with positions of YAML nodes (JSON syntax errors are reported more precisely by protojson below)
+		doc := &yaml.Node{}
+		err := yaml.Unmarshal(cfgBytes, doc)
+		if err != nil && format == FormatYAML {
+			v.addError(path, err)
+			return false
+		}
+		if err == nil && doc.Kind == yaml.DocumentNode && len(doc.Content) == 1 {
+			root := resolveAlias(doc.Content[0])
+			v.trees[path] = root
+			md := (&configv2.Config{}).ProtoReflect().Descriptor()
+			v.version = "v2"
+			if version := mappingValue(root, "version"); version != nil && version.Value == "v1" {
+				md = (&configv1.Config{}).ProtoReflect().Descriptor()
+				v.version = "v1"
+			}
+			v.checkMessage(path, root, md, true)
+		}
+		if len(v.diagnostics) > count {
+			return false
+		}
+	}
+	if format == FormatTOML {
+		if _, err := toJSON(cfgBytes, format); err != nil {
+			v.addError(path, err)
+			return false
+		}
+	}
+	if _, err := UnmarshalAndValidateConfiguration(cfgBytes, format); err != nil { // catches everything not covered above
+		if format == FormatTOML { // positions refer to TOML document converted to JSON, so they are meaningless
+			v.diagnostics = append(v.diagnostics, Diagnostic{Path: path, Message: err.Error()})
+		} else {
+			v.addError(path, err)
+		}
+		return false
+	}
+	return true
+}
+
+// addError adds diagnostic for the given error, extracting position from its message, if present.
+func (v *validator) addError(path string, err error) {
+	d := Diagnostic{Path: path, Message: err.Error()}
+	if m := errorPositionRegexp.FindStringSubmatch(d.Message); m != nil {
+		d.Line, _ = strconv.Atoi(m[1])
+		d.Column, _ = strconv.Atoi(m[2])
+	}
+	v.diagnostics = append(v.diagnostics, d)
+}
+
+func (v *validator) checkMessage(path string, node *yaml.Node, md protoreflect.MessageDescriptor, isRoot bool) {
+	if isNull(node) {
+		return
+	}
+	if node.Kind != yaml.MappingNode {
+		v.add(path, node, "expected object of type %s", md.Name())
+		return
+	}
+	seen := map[protoreflect.FieldNumber]bool{}
+	seenOneofs := map[protoreflect.FullName]string{}
+	versionSet := false
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], resolveAlias(node.Content[i+1])
+		fd := fieldByName(md, key.Value)
+		if fd == nil {
+			v.add(path, key, "unknown field %q in %s", key.Value, md.Name())
+			continue
+		}
+		if seen[fd.Number()] {
+			v.add(path, key, "duplicate field %q", key.Value)
+			continue
+		}
+		seen[fd.Number()] = true
+		if od := fd.ContainingOneof(); od != nil {
+			if other, ok := seenOneofs[od.FullName()]; ok {
+				v.add(path, key, "fields %q and %q cannot be set together", other, key.Value)
+				continue
+			}
+			seenOneofs[od.FullName()] = key.Value
+		}
+		if fd.Name() == "version" {
+			versionSet = true
+			v.checkVersion(path, value, isRoot)
+			continue
+		}
+		v.checkField(path, value, fd)
+	}
+	if isRoot && md.Fields().ByName("version") != nil && !versionSet {
+		v.add(path, node, "missing field \"version\" (unset version is unsupported)")
+	}
+}
+
+func fieldByName(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if fd := md.Fields().ByJSONName(name); fd != nil {
+		return fd
+	}
+	return md.Fields().ByName(protoreflect.Name(name))
+}
+
+func (v *validator) checkVersion(path string, node *yaml.Node, isRoot bool) {
+	if node.Kind != yaml.ScalarNode || node.ShortTag() != "!!str" {
+		v.add(path, node, "version must be a string")
+		return
+	}
+	err := checkRelaxedVersionString(node.Value, v.version)
+	if isRoot {
+		err = checkStrictVersionString(node.Value, configurationVersions...)
+	}
+	if err != nil {
+		v.add(path, node, "%v", err)
+	}
+}
+
+func (v *validator) checkField(path string, node *yaml.Node, fd protoreflect.FieldDescriptor) {
+	switch {
+	case isNull(node):
+	case fd.IsMap():
+		if node.Kind != yaml.MappingNode {
+			v.add(path, node, "field %q must be an object", fd.Name())
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			v.checkValue(path, resolveAlias(node.Content[i+1]), fd.MapValue())
+		}
+	case fd.IsList():
+		if node.Kind != yaml.SequenceNode {
+			v.add(path, node, "field %q must be a list", fd.Name())
+			return
+		}
+		for _, elem := range node.Content {
+			v.checkValue(path, resolveAlias(elem), fd)
+		}
+	default:
+		v.checkValue(path, node, fd)
+	}
+}
+
+func (v *validator) checkValue(path string, node *yaml.Node, fd protoreflect.FieldDescriptor) {
+	if fd.Kind() == protoreflect.MessageKind {
+		v.checkMessage(path, node, fd.Message(), false)
+		return
+	}
+	if node.Kind != yaml.ScalarNode {
+		v.add(path, node, "field %q must be a %s", fd.Name(), kindName(fd))
+		return
+	}
+	switch tag := node.ShortTag(); fd.Kind() {
+	case protoreflect.StringKind:
+		if tag != "!!str" {
+			v.add(path, node, "field %q must be a string (quote the value)", fd.Name())
+		}
+	case protoreflect.BoolKind:
+		if tag != "!!bool" {
+			v.add(path, node, "field %q must be a boolean", fd.Name())
+		}
+	case protoreflect.EnumKind:
+		if tag == "!!int" {
+			return
+		}
+		if fd.Enum().Values().ByName(protoreflect.Name(node.Value)) == nil {
+			v.add(path, node, "invalid value %q of field %q", node.Value, fd.Name())
+		}
+	default:
+		if tag != "!!int" && tag != "!!float" && tag != "!!str" {
+			v.add(path, node, "field %q must be a number", fd.Name())
+		}
+	}
+}
+
+func kindName(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.StringKind, protoreflect.EnumKind:
+		return "string"
+	case protoreflect.BoolKind:
+		return "boolean"
+	}
+	return "number"
+}
+
+func isNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null"
+}
@@ -1,8 +1,6 @@
 package descriptor
 
 import (
-	"bytes"
-	"encoding/binary"
 	"fmt"
 	"io"
 	"os"
@@ -13,18 +11,10 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
-	configv1 "github.com/daishe/impostorcmd/config/v1"
+	configv2 "github.com/daishe/impostorcmd/config/v2"
 	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
 )
 
-const fileMagic = "IMPOSTOR"
-const fileMagicBytesLen = len(fileMagic)
-
-const descriptorMaxSize = 10 * 1024 * 1024 // descriptor maximum size - 10 MiB is more than enough
-const descriptorSizeBytesLen = 4
-
-var descriptorSizeEncoding binary.ByteOrder = binary.BigEndian
-
 type ErrorNoDescriptor struct {
 }
 
@@ -43,6 +33,19 @@ func (e ErrorDescriptorUnsupportedVersion) Error() string {
 	return fmt.Sprintf("impostor descriptor version %s is unsupported", e.Version)
 }
 
+type ErrorCommandNotFound struct {
+	Cmd string
+	Err error
+}
+
+func (e ErrorCommandNotFound) Error() string {
+	return fmt.Sprintf("cannot find command %s: %v", e.Cmd, e.Err)
+}
+
+func (e ErrorCommandNotFound) Unwrap() error {
+	return e.Err
+}
+
 func Lookup(path string) (absPath string, err error) {
 	clean := func(path string) (string, error) {
 		path, err := filepath.EvalSymlinks(path)
@@ -78,63 +81,101 @@ func Lookup(path string) (absPath string, err error) {
 	return "", fmt.Errorf("cannot find file under path %s", absPath)
 }
 
-func FromTarget(target *configv1.Target) (*impostordatav1.TargetDescriptor, error) {
+func FromTarget(target *configv2.Target) (*impostordatav1.TargetDescriptor, error) {
 	cmd, err := Lookup(target.GetCmd())
 	if err != nil {
-		return nil, fmt.Errorf("cannot find command %s: %w", target.Cmd, err)
-	}
-
-	desc := &impostordatav1.TargetDescriptor{
-		Version:         "v1",
-		OriginalCmd:     cmd,
-		ImpostorCmd:     target.GetImpostor(),
-		ImpostorCmdArgs: target.GetImpostorArgs(),
-		IncludeArg_0:    target.GetIncludeArg_0(),
+		return nil, ErrorCommandNotFound{Cmd: target.Cmd, Err: err}
 	}
+	desc := FromTargetUnresolved(target)
+	desc.OriginalCmd = cmd
 	return desc, nil
 }
 
-func FromExecutable(r io.ReadSeeker) (*impostordatav1.TargetDescriptor, error) {
-	if size, err := r.Seek(0, io.SeekEnd); err != nil {
-		return nil, fmt.Errorf("reading impostor descriptor: %w", err)
-	} else if size < int64(descriptorSizeBytesLen+fileMagicBytesLen) {
-		return nil, ErrorNoDescriptor{}
-	}
+// FromTargetUnresolved returns descriptor of the given target as FromTarget does, but without looking up the command (original command is left as written in the target).
+func FromTargetUnresolved(target *configv2.Target) *impostordatav1.TargetDescriptor {
+	desc := &impostordatav1.TargetDescriptor{
+		Version:          "v1",
+		OriginalCmd:      target.GetCmd(),
+		IncludeArg_0:     target.GetRuntime().GetIncludeArg_0(),
+		VerifyOriginal:   target.GetRuntime().GetVerifyOriginal(),
+		Env:              target.GetRuntime().GetEnv(),
+		DeclineExitCode:  target.GetRuntime().GetDeclineExitCode(),
+		DeclineControlFd: target.GetRuntime().GetDeclineControlFd(),
+		PinImpostor:      target.GetRuntime().GetPin(),
+		Provenance: &impostordatav1.Provenance{
+			Description: target.GetDescription(),
+			Owner:       target.GetOwner(),
+		},
+	}
+	h := fromHandler(target.GetHandler())
+	desc.ImpostorCmd, desc.ImpostorCmdArgs, desc.ImpostorCmdArgsTemplate, desc.Builtin, desc.Script = h.ImpostorCmd, h.ImpostorCmdArgs, h.ImpostorCmdArgsTemplate, h.Builtin, h.Script
+	for _, r := range target.GetRules() {
+		rule := fromHandler(r.GetHandler())
+		rule.Match = fromRuleMatch(r.GetMatch())
+		desc.Rules = append(desc.Rules, rule)
+	}
+	return desc
+}
 
-	if _, err := r.Seek(-int64(descriptorSizeBytesLen+fileMagicBytesLen), io.SeekEnd); err != nil {
-		return nil, fmt.Errorf("reading impostor descriptor: %w", err)
-	}
+// fromHandler returns descriptor fields of the given handler, in a rule (with no match), as it consists of exactly those fields.
+func fromHandler(h *configv2.Handler) *impostordatav1.Rule {
+	switch k := h.GetKind().(type) {
+	case *configv2.Handler_External:
+		return &impostordatav1.Rule{ImpostorCmd: k.External.GetCmd(), ImpostorCmdArgs: k.External.GetArgs(), ImpostorCmdArgsTemplate: k.External.GetArgsTemplate()}
+	case *configv2.Handler_Builtin:
+		return &impostordatav1.Rule{Builtin: &impostordatav1.BuiltinHandler{Name: k.Builtin.GetName(), Options: k.Builtin.GetOptions(), Rewrites: fromRewrites(k.Builtin.GetRewrites())}}
+	case *configv2.Handler_Script:
+		return &impostordatav1.Rule{Script: &impostordatav1.ScriptHandler{Interpreter: k.Script.GetInterpreter(), Source: k.Script.GetSource()}}
+	}
+	return &impostordatav1.Rule{}
+}
 
-	sizeAndMagicBytes := [descriptorSizeBytesLen + fileMagicBytesLen]byte{}
-	if _, err := io.ReadFull(r, sizeAndMagicBytes[:]); err != nil {
-		if err == io.EOF || err == io.ErrUnexpectedEOF {
-			return nil, ErrorNoDescriptor{}
+func fromRewrites(rewrites []*configv2.ArgumentRewrite) []*impostordatav1.ArgumentRewrite {
+	converted := make([]*impostordatav1.ArgumentRewrite, 0, len(rewrites))
+	for _, r := range rewrites {
+		c := &impostordatav1.ArgumentRewrite{}
+		switch k := r.GetKind().(type) {
+		case *configv2.ArgumentRewrite_Insert:
+			c.Kind = &impostordatav1.ArgumentRewrite_Insert{Insert: &impostordatav1.InsertArgs{Args: k.Insert.GetArgs(), AtEnd: k.Insert.GetAtEnd()}}
+		case *configv2.ArgumentRewrite_Drop:
+			c.Kind = &impostordatav1.ArgumentRewrite_Drop{Drop: &impostordatav1.DropArgs{Flags: k.Drop.GetFlags(), WithValue: k.Drop.GetWithValue()}}
+		case *configv2.ArgumentRewrite_Replace:
+			c.Kind = &impostordatav1.ArgumentRewrite_Replace{Replace: &impostordatav1.ReplaceArgs{Regex: k.Replace.GetRegex(), Replacement: k.Replace.GetReplacement()}}
+		case *configv2.ArgumentRewrite_RemapSubcommand:
+			c.Kind = &impostordatav1.ArgumentRewrite_RemapSubcommand{RemapSubcommand: &impostordatav1.RemapSubcommand{From: k.RemapSubcommand.GetFrom(), To: k.RemapSubcommand.GetTo()}}
 		}
-		return nil, fmt.Errorf("reading impostor descriptor: %w", err)
-	}
-	sizeBytes, magicBytes := sizeAndMagicBytes[0:descriptorSizeBytesLen], sizeAndMagicBytes[descriptorSizeBytesLen:]
-	if !bytes.Equal(magicBytes, []byte("IMPOSTOR")) {
-		return nil, ErrorNoDescriptor{}
-	}
-	size := descriptorSizeEncoding.Uint32(sizeBytes)
-	if size > descriptorMaxSize { // 10 MiB is more than enough
-		return nil, fmt.Errorf("impostor descriptor is too large")
-	}
-	if size == 0 {
-		return nil, fmt.Errorf("impostor descriptor is empty")
+		converted = append(converted, c)
 	}
+	return converted
+}
 
-	if _, err := r.Seek(-int64(descriptorSizeBytesLen+fileMagicBytesLen)-int64(size), io.SeekEnd); err != nil {
-		return nil, fmt.Errorf("reading impostor descriptor: %w", err)
+func fromRuleMatch(m *configv2.RuleMatch) *impostordatav1.RuleMatch {
+	if m == nil {
+		return nil
+	}
+	return &impostordatav1.RuleMatch{
+		ArgsRegex: m.ArgsRegex,
+		ArgsGlob:  m.ArgsGlob,
+		EnvSet:    m.EnvSet,
+		EnvEquals: m.EnvEquals,
+		CwdPrefix: m.CwdPrefix,
+		Uid:       m.Uid,
+		Gid:       m.Gid,
+		Parent:    m.Parent,
+		StdinTty:  m.StdinTty,
+		StdoutTty: m.StdoutTty,
 	}
+}
 
-	descBytes := make([]byte, int(size))
-	if _, err := io.ReadFull(r, descBytes); err != nil {
-		if err == io.EOF || err == io.ErrUnexpectedEOF {
-			return nil, fmt.Errorf("reading impostor descriptor: invalid descriptor size")
-		}
-		return nil, fmt.Errorf("reading impostor descriptor: %w", err)
+func FromExecutable(r io.ReadSeeker) (*impostordatav1.TargetDescriptor, error) {
+	descBytes, _, err := readTrailer(r)
+	if err != nil {
+		return nil, err
 	}
+	return unmarshalDescriptor(descBytes)
+}
+
+func unmarshalDescriptor(descBytes []byte) (*impostordatav1.TargetDescriptor, error) {
 	descVer := &impostordatav1.ObjectVersion{}
 	if err := proto.Unmarshal(descBytes, descVer); err != nil {
 		return nil, fmt.Errorf("unmarshalling impostor descriptor version: %w", err)
@@ -160,27 +201,20 @@ func checkVersionString(v string) error {
 }
 
 func AppendToExecutable(w io.WriteSeeker, desc *impostordatav1.TargetDescriptor) error {
-	if err := checkVersionString(desc.Version); err != nil {
-		return fmt.Errorf("marshalling impostor descriptor: %w", err)
-	}
-	descBytes, err := proto.Marshal(desc)
+	descBytes, err := marshalDescriptor(desc)
 	if err != nil {
-		return fmt.Errorf("marshalling impostor descriptor: %w", err)
-	}
-	if len(descBytes) > descriptorMaxSize {
-		return fmt.Errorf("impostor descriptor is too large")
+		return err
 	}
-	sizeAndMagicBytes := [descriptorSizeBytesLen + fileMagicBytesLen]byte{}
-	descriptorSizeEncoding.PutUint32(sizeAndMagicBytes[0:descriptorSizeBytesLen], uint32(len(descBytes)))
-	copy(sizeAndMagicBytes[descriptorSizeBytesLen:], []byte(fileMagic))
-
-	descBytes = append(descBytes, sizeAndMagicBytes[:]...)
+	return writeTrailer(w, descBytes, nil)
+}
 
-	if _, err := w.Seek(0, io.SeekEnd); err != nil {
-		return fmt.Errorf("writing impostor descriptor: %w", err)
+func marshalDescriptor(desc *impostordatav1.TargetDescriptor) ([]byte, error) {
+	if err := checkVersionString(desc.Version); err != nil {
+		return nil, fmt.Errorf("marshalling impostor descriptor: %w", err)
 	}
-	if _, err := w.Write(descBytes); err != nil {
-		return fmt.Errorf("writing impostor descriptor: %w", err)
+	descBytes, err := proto.Marshal(desc)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling impostor descriptor: %w", err)
 	}
-	return nil
+	return descBytes, nil
 }
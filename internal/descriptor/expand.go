@@ -0,0 +1,152 @@
+package descriptor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	configv2 "github.com/daishe/impostorcmd/config/v2"
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+type ErrorNoMatch struct {
+	Pattern string
+}
+
+func (e ErrorNoMatch) Error() string {
+	return fmt.Sprintf("no command matches %s", e.Pattern)
+}
+
+// IsMultiTarget reports whether the given target may name more than one command, that is whether its command is a glob pattern or is to be searched along the whole PATH.
+func IsMultiTarget(target *configv2.Target) bool {
+	return target.GetAllInPath() || isGlobPattern(target.GetCmd())
+}
+
+func isGlobPattern(s string) bool {
+	if runtime.GOOS == "windows" {
+		return strings.ContainsAny(s, "*?[")
+	}
+	return strings.ContainsAny(s, `*?[\`)
+}
+
+// IsCommandMissing reports whether the given error means, that a target names no existing command.
+func IsCommandMissing(err error) bool {
+	return errors.As(err, &ErrorCommandNotFound{}) || errors.As(err, &ErrorNoMatch{})
+}
+
+// FromTargetExpanded returns descriptors of all commands named by the given target. Target naming exactly one command results in the same single descriptor as FromTarget. Otherwise every matching command results in a separate descriptor, with provenance recording the pattern it has been expanded from. Commands, that are moved away originals of matching impostors, are left out, so that a pattern matching a whole directory never impostors them once more.
+func FromTargetExpanded(target *configv2.Target) ([]*impostordatav1.TargetDescriptor, error) {
+	if !IsMultiTarget(target) {
+		desc, err := FromTarget(target)
+		if err != nil {
+			return nil, err
+		}
+		return []*impostordatav1.TargetDescriptor{desc}, nil
+	}
+
+	cmds, err := Cmds(target)
+	if err != nil {
+		return nil, err
+	}
+	descs := make([]*impostordatav1.TargetDescriptor, 0, len(cmds))
+	for _, cmd := range cmds {
+		t := proto.Clone(target).(*configv2.Target)
+		t.Cmd, t.AllInPath = cmd, false
+		desc, err := FromTarget(t)
+		if err != nil {
+			return nil, err
+		}
+		desc.Provenance.CmdPattern = target.GetCmd()
+		descs = append(descs, desc)
+	}
+	return descs, nil
+}
+
+// Cmds returns resolved paths of all commands named by the given target, the same that FromTargetExpanded returns descriptors of.
+func Cmds(target *configv2.Target) ([]string, error) {
+	if !IsMultiTarget(target) {
+		cmd, err := Lookup(target.GetCmd())
+		if err != nil {
+			return nil, ErrorCommandNotFound{Cmd: target.GetCmd(), Err: err}
+		}
+		return []string{cmd}, nil
+	}
+	return expandCmd(target.GetCmd(), target.GetAllInPath())
+}
+
+func expandCmd(pattern string, allInPath bool) ([]string, error) {
+	if runtime.GOOS == "windows" {
+		pattern = strings.ReplaceAll(pattern, "/", string(os.PathSeparator))
+	}
+	patterns := []string{pattern}
+	if allInPath {
+		if strings.ContainsRune(pattern, os.PathSeparator) {
+			return nil, fmt.Errorf("command %s must be a name (not a path), when searched along whole PATH", pattern)
+		}
+		patterns = patterns[:0]
+		for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+			if !filepath.IsAbs(dir) { // relative entries (including empty ones meaning current directory) are ignored, as with command lookup
+				continue
+			}
+			patterns = append(patterns, filepath.Join(dir, pattern))
+		}
+	}
+
+	cmds := []string(nil)
+	seen := map[string]bool{}
+	originals := map[string]bool{}
+	for _, p := range patterns {
+		matches, err := filepath.Glob(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid command pattern %s: %w", pattern, err)
+		}
+		for _, m := range matches {
+			if _, err := exec.LookPath(m); err != nil { // not an executable file
+				continue
+			}
+			cmd, err := Lookup(m)
+			if err != nil {
+				return nil, err
+			}
+			if seen[cmd] { // the same command may be reachable through symbolic links or repeated PATH entries
+				continue
+			}
+			seen[cmd] = true
+			cmds = append(cmds, cmd)
+			if original := impostorOriginal(cmd); original != "" {
+				originals[original] = true
+			}
+		}
+	}
+
+	filtered := cmds[:0]
+	for _, cmd := range cmds {
+		if !originals[cmd] {
+			filtered = append(filtered, cmd)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil, ErrorNoMatch{Pattern: pattern}
+	}
+	return filtered, nil
+}
+
+// impostorOriginal returns original command of the given command, if it is an impostor, or empty string otherwise.
+func impostorOriginal(cmd string) string {
+	f, err := os.Open(cmd)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	desc, err := FromExecutable(f)
+	if err != nil {
+		return ""
+	}
+	return desc.OriginalCmd
+}
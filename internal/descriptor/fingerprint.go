@@ -0,0 +1,131 @@
+package descriptor
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+type ErrorNoFingerprint struct {
+}
+
+func (e ErrorNoFingerprint) Error() string {
+	return "no fingerprint recorded"
+}
+
+type ErrorFingerprintMismatch struct {
+	Path       string
+	Mismatches []string // descriptions of mismatched fingerprint fields
+}
+
+func (e ErrorFingerprintMismatch) Error() string {
+	return fmt.Sprintf("%s does not match recorded fingerprint (mismatched: %s)", e.Path, strings.Join(e.Mismatches, ", "))
+}
+
+// Fingerprint captures fingerprint of the file under the given path.
+func Fingerprint(path string) (*impostordatav1.FileFingerprint, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("fingerprinting %s: %w", path, err)
+	}
+	defer f.Close()
+	return FingerprintFile(f)
+}
+
+// FingerprintFile captures fingerprint of the given opened file.
+func FingerprintFile(f *os.File) (*impostordatav1.FileFingerprint, error) {
+	// stat through the opened file, so that the metadata describes exactly the hashed file
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("fingerprinting %s: %w", f.Name(), err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("fingerprinting %s: %w", f.Name(), err)
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, fmt.Errorf("fingerprinting %s: %w", f.Name(), err)
+	}
+
+	return &impostordatav1.FileFingerprint{
+		Sha256:        h.Sum(nil),
+		Size:          stat.Size(),
+		Mode:          uint32(stat.Mode()),
+		Owner:         fileOwner(stat),
+		MtimeUnixNano: stat.ModTime().UnixNano(),
+	}, nil
+}
+
+// VerifyFingerprint checks whether the file under the given path matches the given fingerprint. If the given fingerprint is unset, ErrorNoFingerprint error is returned.
+func VerifyFingerprint(path string, fp *impostordatav1.FileFingerprint) error {
+	if fp == nil {
+		return ErrorNoFingerprint{}
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("fingerprinting %s: %w", path, err)
+	}
+	defer f.Close()
+	return VerifyFileFingerprint(f, fp)
+}
+
+// QuickVerifyFingerprint checks whether the file under the given path matches the given fingerprint, as VerifyFingerprint does, but without hashing the file, when both its identity (device, inode and status change time) and metadata match the recorded ones. Contents of a file cannot change without changing its status change time, so hashing is needed only when the file has been touched in any way since the fingerprint was captured.
+func QuickVerifyFingerprint(path string, fp *impostordatav1.FileFingerprint) error {
+	if fp == nil {
+		return ErrorNoFingerprint{}
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("fingerprinting %s: %w", path, err)
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("fingerprinting %s: %w", path, err)
+	}
+	if id := FileIdentityOf(stat); fp.Identity != nil && id != nil && proto.Equal(id, fp.Identity) && stat.Size() == fp.Size && uint32(stat.Mode()) == fp.Mode && stat.ModTime().UnixNano() == fp.MtimeUnixNano {
+		return nil
+	}
+	return VerifyFileFingerprint(f, fp)
+}
+
+// VerifyFileFingerprint checks whether the given opened file matches the given fingerprint. If the given fingerprint is unset, ErrorNoFingerprint error is returned.
+func VerifyFileFingerprint(f *os.File, fp *impostordatav1.FileFingerprint) error {
+	if fp == nil {
+		return ErrorNoFingerprint{}
+	}
+	path := f.Name()
+	actual, err := FingerprintFile(f)
+	if err != nil {
+		return err
+	}
+
+	mismatched := []string(nil)
+	if !bytes.Equal(actual.Sha256, fp.Sha256) {
+		mismatched = append(mismatched, fmt.Sprintf("sha256 %s != %s", hex.EncodeToString(actual.Sha256), hex.EncodeToString(fp.Sha256)))
+	}
+	if actual.Size != fp.Size {
+		mismatched = append(mismatched, fmt.Sprintf("size %d != %d", actual.Size, fp.Size))
+	}
+	if actual.Mode != fp.Mode {
+		mismatched = append(mismatched, fmt.Sprintf("mode %s != %s", os.FileMode(actual.Mode), os.FileMode(fp.Mode)))
+	}
+	if fp.Owner != nil && actual.Owner != nil && (actual.Owner.Uid != fp.Owner.Uid || actual.Owner.Gid != fp.Owner.Gid) {
+		mismatched = append(mismatched, fmt.Sprintf("owner %d:%d != %d:%d", actual.Owner.Uid, actual.Owner.Gid, fp.Owner.Uid, fp.Owner.Gid))
+	}
+	if actual.MtimeUnixNano != fp.MtimeUnixNano {
+		mismatched = append(mismatched, "mtime")
+	}
+	if len(mismatched) > 0 {
+		return ErrorFingerprintMismatch{Path: path, Mismatches: mismatched}
+	}
+	return nil
+}
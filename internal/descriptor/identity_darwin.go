@@ -0,0 +1,19 @@
+//go:build darwin
+
+package descriptor
+
+import (
+	"os"
+	"syscall"
+
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+// FileIdentityOf returns identity of the given file, or nil if no such information is available.
+func FileIdentityOf(stat os.FileInfo) *impostordatav1.FileIdentity {
+	sys, ok := stat.Sys().(*syscall.Stat_t)
+	if !ok || sys == nil {
+		return nil
+	}
+	return &impostordatav1.FileIdentity{Dev: uint64(sys.Dev), Ino: sys.Ino, CtimeUnixNano: sys.Ctimespec.Nano()}
+}
@@ -0,0 +1,14 @@
+//go:build !(linux || darwin)
+
+package descriptor
+
+import (
+	"os"
+
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+// FileIdentityOf returns identity of the given file, or nil if no such information is available. This function is a dummy, no-op implementation, that always return nil, when the given system is not supported.
+func FileIdentityOf(stat os.FileInfo) *impostordatav1.FileIdentity {
+	return nil
+}
@@ -0,0 +1,14 @@
+//go:build !(linux || darwin)
+
+package descriptor
+
+import (
+	"os"
+
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+// fileOwner returns owner and group information of the given file, or nil if no such information is available. This function is a dummy, no-op implementation, that always return nil, when the given system is not supported.
+func fileOwner(stat os.FileInfo) *impostordatav1.FileOwner {
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build linux || darwin
+
+package descriptor
+
+import (
+	"os"
+	"syscall"
+
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+// fileOwner returns owner and group information of the given file, or nil if no such information is available.
+func fileOwner(stat os.FileInfo) *impostordatav1.FileOwner {
+	sys, ok := stat.Sys().(*syscall.Stat_t)
+	if !ok || sys == nil {
+		return nil
+	}
+	return &impostordatav1.FileOwner{Uid: sys.Uid, Gid: sys.Gid}
+}
@@ -0,0 +1,151 @@
+package descriptor
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	impostordatav1 "github.com/daishe/impostorcmd/internal/impostordata/v1"
+)
+
+const signatureAlgorithmEd25519 = "ed25519"
+
+type ErrorSignatureInvalid struct {
+	Reason string
+}
+
+func (e ErrorSignatureInvalid) Error() string {
+	return fmt.Sprintf("impostor descriptor signature is invalid (%s)", e.Reason)
+}
+
+// Signed is a descriptor together with its signature.
+type Signed struct {
+	Descriptor *impostordatav1.TargetDescriptor
+	Signature  *impostordatav1.DescriptorSignature // nil when descriptor is unsigned
+
+	descBytes []byte
+}
+
+// Verify checks whether signature has been made for the descriptor with the private key matching public key embedded in the signature. It does not check whether the public key is trusted. If descriptor is unsigned, ErrorSignatureInvalid error is returned.
+func (s *Signed) Verify() error {
+	if s.Signature == nil {
+		return ErrorSignatureInvalid{"descriptor is not signed"}
+	}
+	if s.Signature.Algorithm != signatureAlgorithmEd25519 {
+		return ErrorSignatureInvalid{fmt.Sprintf("unsupported algorithm %q", s.Signature.Algorithm)}
+	}
+	if len(s.Signature.PublicKey) != ed25519.PublicKeySize {
+		return ErrorSignatureInvalid{"malformed public key"}
+	}
+	if !ed25519.Verify(ed25519.PublicKey(s.Signature.PublicKey), s.descBytes, s.Signature.Signature) {
+		return ErrorSignatureInvalid{"signature does not match descriptor"}
+	}
+	return nil
+}
+
+// SignedBy reports whether descriptor has been signed with private key matching any of the given public keys. It does not verify the signature itself.
+func (s *Signed) SignedBy(keys []ed25519.PublicKey) bool {
+	if s.Signature == nil {
+		return false
+	}
+	for _, k := range keys {
+		if bytes.Equal(k, s.Signature.PublicKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// SignedFromExecutable reads descriptor together with its signature (if any) from the given executable.
+func SignedFromExecutable(r io.ReadSeeker) (*Signed, error) {
+	descBytes, sigBytes, err := readTrailer(r)
+	if err != nil {
+		return nil, err
+	}
+	desc, err := unmarshalDescriptor(descBytes)
+	if err != nil {
+		return nil, err
+	}
+	s := &Signed{Descriptor: desc, descBytes: descBytes}
+	if sigBytes != nil {
+		s.Signature = &impostordatav1.DescriptorSignature{}
+		if err := proto.Unmarshal(sigBytes, s.Signature); err != nil {
+			return nil, fmt.Errorf("unmarshalling impostor descriptor signature: %w", err)
+		}
+	}
+	return s, nil
+}
+
+// AppendSignedToExecutable appends descriptor signed with the given key to the given executable.
+func AppendSignedToExecutable(w io.WriteSeeker, desc *impostordatav1.TargetDescriptor, key ed25519.PrivateKey) error {
+	descBytes, err := marshalDescriptor(desc)
+	if err != nil {
+		return err
+	}
+	sig := &impostordatav1.DescriptorSignature{
+		Algorithm: signatureAlgorithmEd25519,
+		PublicKey: key.Public().(ed25519.PublicKey),
+		Signature: ed25519.Sign(key, descBytes),
+	}
+	sigBytes, err := proto.Marshal(sig)
+	if err != nil {
+		return fmt.Errorf("marshalling impostor descriptor signature: %w", err)
+	}
+	return writeTrailer(w, descBytes, sigBytes)
+}
+
+// LoadSigningKey reads ed25519 private key from PEM encoded PKCS #8 file (as generated by 'openssl genpkey -algorithm ed25519').
+func LoadSigningKey(path string) (ed25519.PrivateKey, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading signing key: %w", err)
+	}
+	block, _ := pem.Decode(b)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("signing key %s is not a PEM encoded PKCS #8 private key", path)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing signing key %s: %w", path, err)
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("signing key %s is not an ed25519 key", path)
+	}
+	return edKey, nil
+}
+
+// ParsePublicKey parses ed25519 public key, that is either base64 encoded raw key or PEM encoded PKIX key (as generated by 'openssl pkey -pubout').
+func ParsePublicKey(s string) (ed25519.PublicKey, error) {
+	s = strings.TrimSpace(s)
+	if block, _ := pem.Decode([]byte(s)); block != nil {
+		if block.Type != "PUBLIC KEY" {
+			return nil, fmt.Errorf("PEM block of type %s is not a public key", block.Type)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		edKey, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("public key is not an ed25519 key")
+		}
+		return edKey, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("public key is neither PEM nor base64 encoded: %w", err)
+	}
+	if len(b) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("public key has invalid size %d", len(b))
+	}
+	return ed25519.PublicKey(b), nil
+}
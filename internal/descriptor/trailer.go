@@ -0,0 +1,203 @@
+package descriptor
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Descriptor is stored in a trailer appended to the impostor executable. There are following trailer layouts:
+//
+//	legacy:     <descriptor bytes><uint32 descriptor size>"IMPOSTOR"
+//	version 2:  <descriptor bytes><checksum><uint32 descriptor size><uint32 trailer version>"IMPOSTRV"
+//	version 3:  <descriptor bytes><signature bytes><uint32 signature size><checksum><uint32 descriptor size><uint32 trailer version>"IMPOSTRV"
+//
+// Checksum is SHA-256 of descriptor bytes followed by signature bytes (if any). Version 3 is used for signed descriptors only. Legacy trailer is only read, never written.
+
+const legacyFileMagic = "IMPOSTOR"
+const fileMagic = "IMPOSTRV"
+const fileMagicBytesLen = len(fileMagic)
+
+const trailerVersionBytesLen = 4
+const trailerVersionChecksum uint32 = 2
+const trailerVersionSigned uint32 = 3
+const checksumBytesLen = sha256.Size
+
+const descriptorMaxSize = 10 * 1024 * 1024 // descriptor maximum size - 10 MiB is more than enough
+const descriptorSizeBytesLen = 4
+
+const signatureMaxSize = 64 * 1024
+const signatureSizeBytesLen = 4
+
+var trailerEncoding = binary.BigEndian
+
+type ErrorDescriptorCorrupted struct {
+	Reason string
+}
+
+func (e ErrorDescriptorCorrupted) Error() string {
+	return fmt.Sprintf("impostor descriptor is corrupted (%s)", e.Reason)
+}
+
+// readTrailer reads raw descriptor bytes and raw signature bytes (nil when descriptor is unsigned) from the trailer at the end of the given executable.
+func readTrailer(r io.ReadSeeker) (descBytes []byte, sigBytes []byte, err error) {
+	fileSize, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading impostor descriptor: %w", err)
+	}
+	if fileSize < int64(fileMagicBytesLen) {
+		return nil, nil, ErrorNoDescriptor{}
+	}
+
+	magicBytes, err := readFromEnd(r, int64(fileMagicBytesLen), fileMagicBytesLen)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case bytes.Equal(magicBytes, []byte(legacyFileMagic)):
+		descBytes, err := readLegacyTrailer(r, fileSize)
+		return descBytes, nil, err
+	case bytes.Equal(magicBytes, []byte(fileMagic)):
+		return readVersionedTrailer(r, fileSize)
+	}
+	return nil, nil, ErrorNoDescriptor{}
+}
+
+func readLegacyTrailer(r io.ReadSeeker, fileSize int64) ([]byte, error) {
+	fixedLen := int64(descriptorSizeBytesLen + fileMagicBytesLen)
+	if fileSize < fixedLen {
+		return nil, ErrorDescriptorCorrupted{"truncated trailer"}
+	}
+	sizeBytes, err := readFromEnd(r, fixedLen, descriptorSizeBytesLen)
+	if err != nil {
+		return nil, err
+	}
+	size, err := checkDescriptorSize(trailerEncoding.Uint32(sizeBytes), fileSize-fixedLen)
+	if err != nil {
+		return nil, err
+	}
+	return readFromEnd(r, fixedLen+size, int(size))
+}
+
+func readVersionedTrailer(r io.ReadSeeker, fileSize int64) ([]byte, []byte, error) {
+	fixedLen := int64(trailerVersionBytesLen + fileMagicBytesLen)
+	if fileSize < fixedLen {
+		return nil, nil, ErrorDescriptorCorrupted{"truncated trailer"}
+	}
+	versionBytes, err := readFromEnd(r, fixedLen, trailerVersionBytesLen)
+	if err != nil {
+		return nil, nil, err
+	}
+	version := trailerEncoding.Uint32(versionBytes)
+	if version != trailerVersionChecksum && version != trailerVersionSigned {
+		return nil, nil, fmt.Errorf("impostor descriptor trailer version %d is unsupported", version)
+	}
+
+	fixedLen += int64(descriptorSizeBytesLen + checksumBytesLen)
+	if fileSize < fixedLen {
+		return nil, nil, ErrorDescriptorCorrupted{"truncated trailer"}
+	}
+	checksumAndSizeBytes, err := readFromEnd(r, fixedLen, checksumBytesLen+descriptorSizeBytesLen)
+	if err != nil {
+		return nil, nil, err
+	}
+	checksum, descSizeBytes := checksumAndSizeBytes[:checksumBytesLen], checksumAndSizeBytes[checksumBytesLen:]
+
+	sigBytes := []byte(nil)
+	if version == trailerVersionSigned {
+		fixedLen += int64(signatureSizeBytesLen)
+		if fileSize < fixedLen {
+			return nil, nil, ErrorDescriptorCorrupted{"truncated trailer"}
+		}
+		sigSizeBytes, err := readFromEnd(r, fixedLen, signatureSizeBytesLen)
+		if err != nil {
+			return nil, nil, err
+		}
+		sigSize := trailerEncoding.Uint32(sigSizeBytes)
+		if sigSize == 0 || sigSize > signatureMaxSize || int64(sigSize) > fileSize-fixedLen {
+			return nil, nil, ErrorDescriptorCorrupted{"invalid signature size"}
+		}
+		fixedLen += int64(sigSize)
+		if sigBytes, err = readFromEnd(r, fixedLen, int(sigSize)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	size, err := checkDescriptorSize(trailerEncoding.Uint32(descSizeBytes), fileSize-fixedLen)
+	if err != nil {
+		return nil, nil, err
+	}
+	descBytes, err := readFromEnd(r, fixedLen+size, int(size))
+	if err != nil {
+		return nil, nil, err
+	}
+	if sum := trailerChecksum(descBytes, sigBytes); !bytes.Equal(sum, checksum) {
+		return nil, nil, ErrorDescriptorCorrupted{"checksum mismatch"}
+	}
+	return descBytes, sigBytes, nil
+}
+
+func trailerChecksum(descBytes []byte, sigBytes []byte) []byte {
+	h := sha256.New()
+	h.Write(descBytes)
+	h.Write(sigBytes)
+	return h.Sum(nil)
+}
+
+func checkDescriptorSize(size uint32, available int64) (int64, error) {
+	switch {
+	case size > descriptorMaxSize:
+		return 0, ErrorDescriptorCorrupted{"descriptor is too large"}
+	case size == 0:
+		return 0, ErrorDescriptorCorrupted{"descriptor is empty"}
+	case int64(size) > available:
+		return 0, ErrorDescriptorCorrupted{"invalid descriptor size"}
+	}
+	return int64(size), nil
+}
+
+func readFromEnd(r io.ReadSeeker, offset int64, n int) ([]byte, error) {
+	if _, err := r.Seek(-offset, io.SeekEnd); err != nil {
+		return nil, fmt.Errorf("reading impostor descriptor: %w", err)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, ErrorDescriptorCorrupted{"truncated trailer"}
+		}
+		return nil, fmt.Errorf("reading impostor descriptor: %w", err)
+	}
+	return b, nil
+}
+
+// writeTrailer appends trailer with the given raw descriptor bytes and raw signature bytes (nil for unsigned descriptor) to the given executable.
+func writeTrailer(w io.WriteSeeker, descBytes []byte, sigBytes []byte) error {
+	if len(descBytes) > descriptorMaxSize {
+		return fmt.Errorf("impostor descriptor is too large")
+	}
+	if len(sigBytes) > signatureMaxSize {
+		return fmt.Errorf("impostor descriptor signature is too large")
+	}
+	version := trailerVersionChecksum
+	trailer := make([]byte, 0, len(descBytes)+len(sigBytes)+signatureSizeBytesLen+checksumBytesLen+descriptorSizeBytesLen+trailerVersionBytesLen+fileMagicBytesLen)
+	trailer = append(trailer, descBytes...)
+	if sigBytes != nil {
+		version = trailerVersionSigned
+		trailer = append(trailer, sigBytes...)
+		trailer = trailerEncoding.AppendUint32(trailer, uint32(len(sigBytes)))
+	}
+	trailer = append(trailer, trailerChecksum(descBytes, sigBytes)...)
+	trailer = trailerEncoding.AppendUint32(trailer, uint32(len(descBytes)))
+	trailer = trailerEncoding.AppendUint32(trailer, version)
+	trailer = append(trailer, fileMagic...)
+
+	if _, err := w.Seek(0, io.SeekEnd); err != nil {
+		return fmt.Errorf("writing impostor descriptor: %w", err)
+	}
+	if _, err := w.Write(trailer); err != nil {
+		return fmt.Errorf("writing impostor descriptor: %w", err)
+	}
+	return nil
+}
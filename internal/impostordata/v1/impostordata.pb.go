@@ -72,11 +72,24 @@ type TargetDescriptor struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version         string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"` // for this object must equal to "v1"
-	OriginalCmd     string   `protobuf:"bytes,2,opt,name=original_cmd,json=originalCmd,proto3" json:"original_cmd,omitempty"`
-	ImpostorCmd     string   `protobuf:"bytes,3,opt,name=impostor_cmd,json=impostorCmd,proto3" json:"impostor_cmd,omitempty"`
-	ImpostorCmdArgs []string `protobuf:"bytes,4,rep,name=impostor_cmd_args,json=impostorCmdArgs,proto3" json:"impostor_cmd_args,omitempty"`
-	IncludeArg_0    bool     `protobuf:"varint,5,opt,name=include_arg_0,json=includeArg0,proto3" json:"include_arg_0,omitempty"`
+	Version                 string            `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"` // for this object must equal to "v1"
+	OriginalCmd             string            `protobuf:"bytes,2,opt,name=original_cmd,json=originalCmd,proto3" json:"original_cmd,omitempty"`
+	ImpostorCmd             string            `protobuf:"bytes,3,opt,name=impostor_cmd,json=impostorCmd,proto3" json:"impostor_cmd,omitempty"`
+	ImpostorCmdArgs         []string          `protobuf:"bytes,4,rep,name=impostor_cmd_args,json=impostorCmdArgs,proto3" json:"impostor_cmd_args,omitempty"`
+	IncludeArg_0            bool              `protobuf:"varint,5,opt,name=include_arg_0,json=includeArg0,proto3" json:"include_arg_0,omitempty"`
+	StackDepth              uint32            `protobuf:"varint,6,opt,name=stack_depth,json=stackDepth,proto3" json:"stack_depth,omitempty"`                                                         // number of impostor layers beneath this one (0 when original command is not an impostor)
+	OriginalFingerprint     *FileFingerprint  `protobuf:"bytes,7,opt,name=original_fingerprint,json=originalFingerprint,proto3" json:"original_fingerprint,omitempty"`                               // fingerprint of the original command captured during install
+	VerifyOriginal          bool              `protobuf:"varint,8,opt,name=verify_original,json=verifyOriginal,proto3" json:"verify_original,omitempty"`                                             // whether to verify original command against its fingerprint on every impostor invocation
+	Provenance              *Provenance       `protobuf:"bytes,9,opt,name=provenance,proto3" json:"provenance,omitempty"`                                                                            // information about the install
+	ImpostorPin             *ImpostorPin      `protobuf:"bytes,10,opt,name=impostor_pin,json=impostorPin,proto3" json:"impostor_pin,omitempty"`                                                      // when set, impostor command is not looked up on invocation, but pinned to the given path and contents
+	Builtin                 *BuiltinHandler   `protobuf:"bytes,11,opt,name=builtin,proto3" json:"builtin,omitempty"`                                                                                 // when set, builtin handler is used instead of the impostor command
+	Script                  *ScriptHandler    `protobuf:"bytes,12,opt,name=script,proto3" json:"script,omitempty"`                                                                                   // when set, inline script is run instead of the impostor command
+	Env                     map[string]string `protobuf:"bytes,13,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // environment variables set for the handler
+	DeclineExitCode         uint32            `protobuf:"varint,14,opt,name=decline_exit_code,json=declineExitCode,proto3" json:"decline_exit_code,omitempty"`                                       // when non-zero, handler exiting with this code declines the invocation and the original command is run instead
+	DeclineControlFd        bool              `protobuf:"varint,15,opt,name=decline_control_fd,json=declineControlFd,proto3" json:"decline_control_fd,omitempty"`                                    // whether to pass handler a control file descriptor, through which it may decline the invocation
+	Rules                   []*Rule           `protobuf:"bytes,16,rep,name=rules,proto3" json:"rules,omitempty"`                                                                                     // rules evaluated in order on every invocation, the first one that matches selects the handler (the handler above is used, when none matches)
+	ImpostorCmdArgsTemplate []string          `protobuf:"bytes,17,rep,name=impostor_cmd_args_template,json=impostorCmdArgsTemplate,proto3" json:"impostor_cmd_args_template,omitempty"`              // when set, impostor command arguments are built from this template instead of impostor_cmd_args and arguments of the original command
+	PinImpostor             bool              `protobuf:"varint,18,opt,name=pin_impostor,json=pinImpostor,proto3" json:"pin_impostor,omitempty"`                                                     // whether impostor commands (including ones of rules) are to be pinned during install (see impostor_pin), regardless of install options
 }
 
 func (x *TargetDescriptor) Reset() {
@@ -146,6 +159,1207 @@ func (x *TargetDescriptor) GetIncludeArg_0() bool {
 	return false
 }
 
+func (x *TargetDescriptor) GetStackDepth() uint32 {
+	if x != nil {
+		return x.StackDepth
+	}
+	return 0
+}
+
+func (x *TargetDescriptor) GetOriginalFingerprint() *FileFingerprint {
+	if x != nil {
+		return x.OriginalFingerprint
+	}
+	return nil
+}
+
+func (x *TargetDescriptor) GetVerifyOriginal() bool {
+	if x != nil {
+		return x.VerifyOriginal
+	}
+	return false
+}
+
+func (x *TargetDescriptor) GetProvenance() *Provenance {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+func (x *TargetDescriptor) GetImpostorPin() *ImpostorPin {
+	if x != nil {
+		return x.ImpostorPin
+	}
+	return nil
+}
+
+func (x *TargetDescriptor) GetBuiltin() *BuiltinHandler {
+	if x != nil {
+		return x.Builtin
+	}
+	return nil
+}
+
+func (x *TargetDescriptor) GetScript() *ScriptHandler {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *TargetDescriptor) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *TargetDescriptor) GetDeclineExitCode() uint32 {
+	if x != nil {
+		return x.DeclineExitCode
+	}
+	return 0
+}
+
+func (x *TargetDescriptor) GetDeclineControlFd() bool {
+	if x != nil {
+		return x.DeclineControlFd
+	}
+	return false
+}
+
+func (x *TargetDescriptor) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *TargetDescriptor) GetImpostorCmdArgsTemplate() []string {
+	if x != nil {
+		return x.ImpostorCmdArgsTemplate
+	}
+	return nil
+}
+
+func (x *TargetDescriptor) GetPinImpostor() bool {
+	if x != nil {
+		return x.PinImpostor
+	}
+	return false
+}
+
+type Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Match                   *RuleMatch      `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`                                // predicates that must all hold for the rule to match
+	ImpostorCmd             string          `protobuf:"bytes,2,opt,name=impostor_cmd,json=impostorCmd,proto3" json:"impostor_cmd,omitempty"` // impostor command run, when the rule matches
+	ImpostorCmdArgs         []string        `protobuf:"bytes,3,rep,name=impostor_cmd_args,json=impostorCmdArgs,proto3" json:"impostor_cmd_args,omitempty"`
+	Builtin                 *BuiltinHandler `protobuf:"bytes,4,opt,name=builtin,proto3" json:"builtin,omitempty"` // when set, builtin handler is used instead of the impostor command
+	Script                  *ScriptHandler  `protobuf:"bytes,5,opt,name=script,proto3" json:"script,omitempty"`   // when set, inline script is run instead of the impostor command
+	ImpostorCmdArgsTemplate []string        `protobuf:"bytes,6,rep,name=impostor_cmd_args_template,json=impostorCmdArgsTemplate,proto3" json:"impostor_cmd_args_template,omitempty"`
+	ImpostorPin             *ImpostorPin    `protobuf:"bytes,7,opt,name=impostor_pin,json=impostorPin,proto3" json:"impostor_pin,omitempty"` // when set, impostor command (or script interpreter) of the rule is not looked up on invocation, but pinned to the given path and contents
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Rule) GetMatch() *RuleMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *Rule) GetImpostorCmd() string {
+	if x != nil {
+		return x.ImpostorCmd
+	}
+	return ""
+}
+
+func (x *Rule) GetImpostorCmdArgs() []string {
+	if x != nil {
+		return x.ImpostorCmdArgs
+	}
+	return nil
+}
+
+func (x *Rule) GetBuiltin() *BuiltinHandler {
+	if x != nil {
+		return x.Builtin
+	}
+	return nil
+}
+
+func (x *Rule) GetScript() *ScriptHandler {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *Rule) GetImpostorCmdArgsTemplate() []string {
+	if x != nil {
+		return x.ImpostorCmdArgsTemplate
+	}
+	return nil
+}
+
+func (x *Rule) GetImpostorPin() *ImpostorPin {
+	if x != nil {
+		return x.ImpostorPin
+	}
+	return nil
+}
+
+type RuleMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArgsRegex string            `protobuf:"bytes,1,opt,name=args_regex,json=argsRegex,proto3" json:"args_regex,omitempty"`                                                                                         // regular expression (RE2 syntax), that must match arguments (without argument #0) joined with single spaces
+	ArgsGlob  []string          `protobuf:"bytes,2,rep,name=args_glob,json=argsGlob,proto3" json:"args_glob,omitempty"`                                                                                            // glob patterns, that must match leading arguments (without argument #0) one by one, for example ["push"] matches "docker push --all"
+	EnvSet    []string          `protobuf:"bytes,3,rep,name=env_set,json=envSet,proto3" json:"env_set,omitempty"`                                                                                                  // names of environment variables that must all be set
+	EnvEquals map[string]string `protobuf:"bytes,4,rep,name=env_equals,json=envEquals,proto3" json:"env_equals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // environment variables that must all be set to the given values
+	CwdPrefix []string          `protobuf:"bytes,5,rep,name=cwd_prefix,json=cwdPrefix,proto3" json:"cwd_prefix,omitempty"`                                                                                         // directories, one of which must contain the working directory (any directory, when empty)
+	Uid       []uint32          `protobuf:"varint,6,rep,packed,name=uid,proto3" json:"uid,omitempty"`                                                                                                              // user IDs, one of which must be the user ID of the invoking process (any user, when empty)
+	Gid       []uint32          `protobuf:"varint,7,rep,packed,name=gid,proto3" json:"gid,omitempty"`                                                                                                              // group IDs, one of which must be the group ID of the invoking process (any group, when empty)
+	Parent    []string          `protobuf:"bytes,8,rep,name=parent,proto3" json:"parent,omitempty"`                                                                                                                // glob patterns, one of which must match name of the parent process executable (any parent, when empty)
+	StdinTty  *bool             `protobuf:"varint,9,opt,name=stdin_tty,json=stdinTty,proto3,oneof" json:"stdin_tty,omitempty"`                                                                                     // whether standard input must (or must not) be a terminal (either, when unset)
+	StdoutTty *bool             `protobuf:"varint,10,opt,name=stdout_tty,json=stdoutTty,proto3,oneof" json:"stdout_tty,omitempty"`                                                                                 // whether standard output must (or must not) be a terminal (either, when unset)
+}
+
+func (x *RuleMatch) Reset() {
+	*x = RuleMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleMatch) ProtoMessage() {}
+
+func (x *RuleMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleMatch.ProtoReflect.Descriptor instead.
+func (*RuleMatch) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RuleMatch) GetArgsRegex() string {
+	if x != nil {
+		return x.ArgsRegex
+	}
+	return ""
+}
+
+func (x *RuleMatch) GetArgsGlob() []string {
+	if x != nil {
+		return x.ArgsGlob
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetEnvSet() []string {
+	if x != nil {
+		return x.EnvSet
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetEnvEquals() map[string]string {
+	if x != nil {
+		return x.EnvEquals
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetCwdPrefix() []string {
+	if x != nil {
+		return x.CwdPrefix
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetUid() []uint32 {
+	if x != nil {
+		return x.Uid
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetGid() []uint32 {
+	if x != nil {
+		return x.Gid
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetParent() []string {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *RuleMatch) GetStdinTty() bool {
+	if x != nil && x.StdinTty != nil {
+		return *x.StdinTty
+	}
+	return false
+}
+
+func (x *RuleMatch) GetStdoutTty() bool {
+	if x != nil && x.StdoutTty != nil {
+		return *x.StdoutTty
+	}
+	return false
+}
+
+type BuiltinHandler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Options  map[string]string  `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Rewrites []*ArgumentRewrite `protobuf:"bytes,3,rep,name=rewrites,proto3" json:"rewrites,omitempty"`
+}
+
+func (x *BuiltinHandler) Reset() {
+	*x = BuiltinHandler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuiltinHandler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinHandler) ProtoMessage() {}
+
+func (x *BuiltinHandler) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinHandler.ProtoReflect.Descriptor instead.
+func (*BuiltinHandler) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BuiltinHandler) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuiltinHandler) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *BuiltinHandler) GetRewrites() []*ArgumentRewrite {
+	if x != nil {
+		return x.Rewrites
+	}
+	return nil
+}
+
+type ArgumentRewrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//	*ArgumentRewrite_Insert
+	//	*ArgumentRewrite_Drop
+	//	*ArgumentRewrite_Replace
+	//	*ArgumentRewrite_RemapSubcommand
+	Kind isArgumentRewrite_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *ArgumentRewrite) Reset() {
+	*x = ArgumentRewrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArgumentRewrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArgumentRewrite) ProtoMessage() {}
+
+func (x *ArgumentRewrite) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArgumentRewrite.ProtoReflect.Descriptor instead.
+func (*ArgumentRewrite) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *ArgumentRewrite) GetKind() isArgumentRewrite_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *ArgumentRewrite) GetInsert() *InsertArgs {
+	if x, ok := x.GetKind().(*ArgumentRewrite_Insert); ok {
+		return x.Insert
+	}
+	return nil
+}
+
+func (x *ArgumentRewrite) GetDrop() *DropArgs {
+	if x, ok := x.GetKind().(*ArgumentRewrite_Drop); ok {
+		return x.Drop
+	}
+	return nil
+}
+
+func (x *ArgumentRewrite) GetReplace() *ReplaceArgs {
+	if x, ok := x.GetKind().(*ArgumentRewrite_Replace); ok {
+		return x.Replace
+	}
+	return nil
+}
+
+func (x *ArgumentRewrite) GetRemapSubcommand() *RemapSubcommand {
+	if x, ok := x.GetKind().(*ArgumentRewrite_RemapSubcommand); ok {
+		return x.RemapSubcommand
+	}
+	return nil
+}
+
+type isArgumentRewrite_Kind interface {
+	isArgumentRewrite_Kind()
+}
+
+type ArgumentRewrite_Insert struct {
+	Insert *InsertArgs `protobuf:"bytes,1,opt,name=insert,proto3,oneof"` // insert arguments
+}
+
+type ArgumentRewrite_Drop struct {
+	Drop *DropArgs `protobuf:"bytes,2,opt,name=drop,proto3,oneof"` // drop flags
+}
+
+type ArgumentRewrite_Replace struct {
+	Replace *ReplaceArgs `protobuf:"bytes,3,opt,name=replace,proto3,oneof"` // replace regular expression matches within every argument
+}
+
+type ArgumentRewrite_RemapSubcommand struct {
+	RemapSubcommand *RemapSubcommand `protobuf:"bytes,4,opt,name=remap_subcommand,json=remapSubcommand,proto3,oneof"` // replace subcommand
+}
+
+func (*ArgumentRewrite_Insert) isArgumentRewrite_Kind() {}
+
+func (*ArgumentRewrite_Drop) isArgumentRewrite_Kind() {}
+
+func (*ArgumentRewrite_Replace) isArgumentRewrite_Kind() {}
+
+func (*ArgumentRewrite_RemapSubcommand) isArgumentRewrite_Kind() {}
+
+type InsertArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Args  []string `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`                 // arguments to insert
+	AtEnd bool     `protobuf:"varint,2,opt,name=at_end,json=atEnd,proto3" json:"at_end,omitempty"` // whether to append the arguments after all others, instead of inserting them before all others
+}
+
+func (x *InsertArgs) Reset() {
+	*x = InsertArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertArgs) ProtoMessage() {}
+
+func (x *InsertArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertArgs.ProtoReflect.Descriptor instead.
+func (*InsertArgs) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InsertArgs) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *InsertArgs) GetAtEnd() bool {
+	if x != nil {
+		return x.AtEnd
+	}
+	return false
+}
+
+type DropArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags     []string `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`                           // flags to drop, both in "--flag" and "--flag=value" form
+	WithValue bool     `protobuf:"varint,2,opt,name=with_value,json=withValue,proto3" json:"with_value,omitempty"` // whether flags take a value, so that the argument following a flag given without "=value" is dropped too
+}
+
+func (x *DropArgs) Reset() {
+	*x = DropArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropArgs) ProtoMessage() {}
+
+func (x *DropArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropArgs.ProtoReflect.Descriptor instead.
+func (*DropArgs) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DropArgs) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *DropArgs) GetWithValue() bool {
+	if x != nil {
+		return x.WithValue
+	}
+	return false
+}
+
+type ReplaceArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Regex       string `protobuf:"bytes,1,opt,name=regex,proto3" json:"regex,omitempty"`             // regular expression (RE2 syntax)
+	Replacement string `protobuf:"bytes,2,opt,name=replacement,proto3" json:"replacement,omitempty"` // replacement, that may refer to submatches ($1, ${name})
+}
+
+func (x *ReplaceArgs) Reset() {
+	*x = ReplaceArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplaceArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceArgs) ProtoMessage() {}
+
+func (x *ReplaceArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceArgs.ProtoReflect.Descriptor instead.
+func (*ReplaceArgs) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReplaceArgs) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+func (x *ReplaceArgs) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+type RemapSubcommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // subcommand to replace (the first argument not starting with "-")
+	To   []string `protobuf:"bytes,2,rep,name=to,proto3" json:"to,omitempty"`     // arguments to replace the subcommand with (dropping it, when empty)
+}
+
+func (x *RemapSubcommand) Reset() {
+	*x = RemapSubcommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemapSubcommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemapSubcommand) ProtoMessage() {}
+
+func (x *RemapSubcommand) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemapSubcommand.ProtoReflect.Descriptor instead.
+func (*RemapSubcommand) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RemapSubcommand) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RemapSubcommand) GetTo() []string {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ScriptHandler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interpreter []string `protobuf:"bytes,1,rep,name=interpreter,proto3" json:"interpreter,omitempty"`
+	Source      string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *ScriptHandler) Reset() {
+	*x = ScriptHandler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScriptHandler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptHandler) ProtoMessage() {}
+
+func (x *ScriptHandler) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScriptHandler.ProtoReflect.Descriptor instead.
+func (*ScriptHandler) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ScriptHandler) GetInterpreter() []string {
+	if x != nil {
+		return x.Interpreter
+	}
+	return nil
+}
+
+func (x *ScriptHandler) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type ImpostorPin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`     // absolute path to the impostor command resolved during install
+	Sha256 []byte `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"` // SHA-256 of the impostor command captured during install
+}
+
+func (x *ImpostorPin) Reset() {
+	*x = ImpostorPin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImpostorPin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpostorPin) ProtoMessage() {}
+
+func (x *ImpostorPin) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpostorPin.ProtoReflect.Descriptor instead.
+func (*ImpostorPin) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImpostorPin) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ImpostorPin) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+type Provenance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstalledAtUnixNano int64  `protobuf:"varint,1,opt,name=installed_at_unix_nano,json=installedAtUnixNano,proto3" json:"installed_at_unix_nano,omitempty"`
+	ImpostorcmdVersion  string `protobuf:"bytes,2,opt,name=impostorcmd_version,json=impostorcmdVersion,proto3" json:"impostorcmd_version,omitempty"`
+	ImpostorcmdCommit   string `protobuf:"bytes,3,opt,name=impostorcmd_commit,json=impostorcmdCommit,proto3" json:"impostorcmd_commit,omitempty"`
+	User                string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`                         // name of the installing user
+	Uid                 string `protobuf:"bytes,5,opt,name=uid,proto3" json:"uid,omitempty"`                           // identifier of the installing user
+	SudoUser            string `protobuf:"bytes,6,opt,name=sudo_user,json=sudoUser,proto3" json:"sudo_user,omitempty"` // name of the user that invoked sudo to install (if any)
+	Hostname            string `protobuf:"bytes,7,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	ConfigPath          string `protobuf:"bytes,8,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"`  // path to configuration file the target originated from (unset when installed without configuration file)
+	Description         string `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`                  // free-form description of the target
+	Owner               string `protobuf:"bytes,10,opt,name=owner,proto3" json:"owner,omitempty"`                             // free-form owner of the target
+	CmdPattern          string `protobuf:"bytes,11,opt,name=cmd_pattern,json=cmdPattern,proto3" json:"cmd_pattern,omitempty"` // glob pattern or name searched along PATH the target command has been expanded from (unset when target named exactly one command)
+}
+
+func (x *Provenance) Reset() {
+	*x = Provenance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Provenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provenance) ProtoMessage() {}
+
+func (x *Provenance) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provenance.ProtoReflect.Descriptor instead.
+func (*Provenance) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Provenance) GetInstalledAtUnixNano() int64 {
+	if x != nil {
+		return x.InstalledAtUnixNano
+	}
+	return 0
+}
+
+func (x *Provenance) GetImpostorcmdVersion() string {
+	if x != nil {
+		return x.ImpostorcmdVersion
+	}
+	return ""
+}
+
+func (x *Provenance) GetImpostorcmdCommit() string {
+	if x != nil {
+		return x.ImpostorcmdCommit
+	}
+	return ""
+}
+
+func (x *Provenance) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Provenance) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *Provenance) GetSudoUser() string {
+	if x != nil {
+		return x.SudoUser
+	}
+	return ""
+}
+
+func (x *Provenance) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *Provenance) GetConfigPath() string {
+	if x != nil {
+		return x.ConfigPath
+	}
+	return ""
+}
+
+func (x *Provenance) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Provenance) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Provenance) GetCmdPattern() string {
+	if x != nil {
+		return x.CmdPattern
+	}
+	return ""
+}
+
+type FileFingerprint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sha256        []byte        `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Size          int64         `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Mode          uint32        `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`  // file mode and permission bits
+	Owner         *FileOwner    `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"` // unset when file owner information is unavailable
+	MtimeUnixNano int64         `protobuf:"varint,5,opt,name=mtime_unix_nano,json=mtimeUnixNano,proto3" json:"mtime_unix_nano,omitempty"`
+	Identity      *FileIdentity `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"` // identity of the file in place (captured after moving original command away during install), unset when unavailable
+}
+
+func (x *FileFingerprint) Reset() {
+	*x = FileFingerprint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileFingerprint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileFingerprint) ProtoMessage() {}
+
+func (x *FileFingerprint) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileFingerprint.ProtoReflect.Descriptor instead.
+func (*FileFingerprint) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FileFingerprint) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+func (x *FileFingerprint) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileFingerprint) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *FileFingerprint) GetOwner() *FileOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *FileFingerprint) GetMtimeUnixNano() int64 {
+	if x != nil {
+		return x.MtimeUnixNano
+	}
+	return 0
+}
+
+func (x *FileFingerprint) GetIdentity() *FileIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type FileIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dev           uint64 `protobuf:"varint,1,opt,name=dev,proto3" json:"dev,omitempty"`
+	Ino           uint64 `protobuf:"varint,2,opt,name=ino,proto3" json:"ino,omitempty"`
+	CtimeUnixNano int64  `protobuf:"varint,3,opt,name=ctime_unix_nano,json=ctimeUnixNano,proto3" json:"ctime_unix_nano,omitempty"` // status change time, that (unlike modification time) cannot be set by users
+}
+
+func (x *FileIdentity) Reset() {
+	*x = FileIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileIdentity) ProtoMessage() {}
+
+func (x *FileIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileIdentity.ProtoReflect.Descriptor instead.
+func (*FileIdentity) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FileIdentity) GetDev() uint64 {
+	if x != nil {
+		return x.Dev
+	}
+	return 0
+}
+
+func (x *FileIdentity) GetIno() uint64 {
+	if x != nil {
+		return x.Ino
+	}
+	return 0
+}
+
+func (x *FileIdentity) GetCtimeUnixNano() int64 {
+	if x != nil {
+		return x.CtimeUnixNano
+	}
+	return 0
+}
+
+type FileOwner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid uint32 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Gid uint32 `protobuf:"varint,2,opt,name=gid,proto3" json:"gid,omitempty"`
+}
+
+func (x *FileOwner) Reset() {
+	*x = FileOwner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileOwner) ProtoMessage() {}
+
+func (x *FileOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileOwner.ProtoReflect.Descriptor instead.
+func (*FileOwner) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FileOwner) GetUid() uint32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *FileOwner) GetGid() uint32 {
+	if x != nil {
+		return x.Gid
+	}
+	return 0
+}
+
+type DescriptorSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Algorithm string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                  // signature algorithm, currently only "ed25519" is supported
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // public key of the signer
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`                  // signature of raw descriptor bytes
+}
+
+func (x *DescriptorSignature) Reset() {
+	*x = DescriptorSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptorSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptorSignature) ProtoMessage() {}
+
+func (x *DescriptorSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_impostordata_v1_impostordata_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptorSignature.ProtoReflect.Descriptor instead.
+func (*DescriptorSignature) Descriptor() ([]byte, []int) {
+	return file_internal_impostordata_v1_impostordata_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DescriptorSignature) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *DescriptorSignature) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *DescriptorSignature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 var File_internal_impostordata_v1_impostordata_proto protoreflect.FileDescriptor
 
 var file_internal_impostordata_v1_impostordata_proto_rawDesc = []byte{
@@ -157,7 +1371,7 @@ var file_internal_impostordata_v1_impostordata_proto_rawDesc = []byte{
 	0x2e, 0x76, 0x31, 0x22, 0x29, 0x0a, 0x0d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x65, 0x72,
 	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc2,
-	0x01, 0x0a, 0x10, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x08, 0x0a, 0x10, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
 	0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
 	0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6d, 0x64, 0x18, 0x02, 0x20,
@@ -169,27 +1383,260 @@ var file_internal_impostordata_v1_impostordata_proto_rawDesc = []byte{
 	0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x43, 0x6d, 0x64, 0x41, 0x72, 0x67, 0x73, 0x12,
 	0x22, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x5f, 0x30,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41,
-	0x72, 0x67, 0x30, 0x42, 0xb7, 0x02, 0x0a, 0x28, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x67, 0x30, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x12, 0x68, 0x0a, 0x14, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x52, 0x13, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x50, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6d,
+	0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x0c, 0x69, 0x6d, 0x70,
+	0x6f, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x50,
+	0x69, 0x6e, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x50, 0x69, 0x6e, 0x12,
+	0x4e, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x34, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x12,
+	0x4b, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x51, 0x0a, 0x03,
+	0x65, 0x6e, 0x76, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x69, 0x6d, 0x70, 0x6f,
+	0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12,
+	0x2a, 0x0a, 0x11, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x63, 0x6c,
+	0x69, 0x6e, 0x65, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64,
+	0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x66,
+	0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x46, 0x64, 0x12, 0x40, 0x0a, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x69,
+	0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6d, 0x64, 0x5f, 0x61, 0x72, 0x67, 0x73,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x17, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x43, 0x6d, 0x64, 0x41, 0x72, 0x67, 0x73,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x69, 0x6e, 0x5f,
+	0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x70, 0x69, 0x6e, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x1a, 0x36, 0x0a, 0x08, 0x45,
+	0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xcc, 0x03, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x45, 0x0a, 0x05,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6d,
+	0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x5f,
+	0x63, 0x6d, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x43, 0x6d, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x5f, 0x63, 0x6d, 0x64, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x43, 0x6d, 0x64, 0x41, 0x72,
+	0x67, 0x73, 0x12, 0x4e, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d,
+	0x64, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74,
+	0x69, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74,
+	0x69, 0x6e, 0x12, 0x4b, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
+	0x3b, 0x0a, 0x1a, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6d, 0x64, 0x5f,
+	0x61, 0x72, 0x67, 0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x17, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x43, 0x6d, 0x64,
+	0x41, 0x72, 0x67, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x54, 0x0a, 0x0c,
+	0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x50, 0x69, 0x6e, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x50,
+	0x69, 0x6e, 0x22, 0xbb, 0x03, 0x0a, 0x09, 0x52, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x67, 0x73, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x67, 0x73, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x73, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x67, 0x73, 0x47, 0x6c, 0x6f, 0x62, 0x12, 0x17, 0x0a, 0x07,
+	0x65, 0x6e, 0x76, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x6e, 0x76, 0x53, 0x65, 0x74, 0x12, 0x5d, 0x0a, 0x0a, 0x65, 0x6e, 0x76, 0x5f, 0x65, 0x71, 0x75,
+	0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x69, 0x6d, 0x70, 0x6f,
 	0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
 	0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31,
-	0x42, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x64, 0x61, 0x69, 0x73, 0x68, 0x65, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f,
-	0x72, 0x63, 0x6d, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x69, 0x6d,
-	0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6d,
-	0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49,
-	0x49, 0x49, 0xaa, 0x02, 0x24, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
-	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74,
-	0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x24, 0x49, 0x6d, 0x70, 0x6f,
-	0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x5c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x5c, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x30, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x5c, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5c, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72,
-	0x64, 0x61, 0x74, 0x61, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x27, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d,
-	0x64, 0x3a, 0x3a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x70,
-	0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x6e, 0x76, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x77, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x77, 0x64, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x69, 0x64, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x03, 0x67, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x09, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x5f, 0x74, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x54, 0x74, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x74, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x09, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x54,
+	0x74, 0x79, 0x88, 0x01, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x45, 0x6e, 0x76, 0x45, 0x71, 0x75, 0x61,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x5f, 0x74, 0x74,
+	0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x74, 0x79,
+	0x22, 0x90, 0x02, 0x0a, 0x0e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x48, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x5b, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x2e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x08, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f,
+	0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69, 0x6d,
+	0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72,
+	0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x08, 0x72,
+	0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xde, 0x02, 0x0a, 0x0f, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x69, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69,
+	0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x72, 0x67, 0x73, 0x48, 0x00, 0x52, 0x06, 0x69, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x12, 0x44, 0x0a, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f,
+	0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x41, 0x72, 0x67,
+	0x73, 0x48, 0x00, 0x52, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x4d, 0x0a, 0x07, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x6d, 0x70,
+	0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x48, 0x00, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x61,
+	0x70, 0x5f, 0x73, 0x75, 0x62, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x70, 0x53,
+	0x75, 0x62, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x6d,
+	0x61, 0x70, 0x53, 0x75, 0x62, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x42, 0x06, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x22, 0x37, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x72,
+	0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x74, 0x5f, 0x65, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x74, 0x45, 0x6e, 0x64, 0x22, 0x3f, 0x0a,
+	0x08, 0x44, 0x72, 0x6f, 0x70, 0x41, 0x72, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x45,
+	0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x61, 0x70, 0x53, 0x75,
+	0x62, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x0d,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x20, 0x0a,
+	0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x39, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x50, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x22, 0xfa, 0x02, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x33, 0x0a, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e,
+	0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x63, 0x6d, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x75, 0x64, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x75, 0x64, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6d, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6d, 0x64, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22,
+	0x90, 0x02, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61,
+	0x6e, 0x6f, 0x12, 0x4e, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63,
+	0x6d, 0x64, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69, 0x6d, 0x70, 0x6f,
+	0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x22, 0x5a, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x64, 0x65, 0x76, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x69, 0x6e, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x2f,
+	0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x67, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x67, 0x69, 0x64, 0x22,
+	0x70, 0x0a, 0x13, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x42, 0xb7, 0x02, 0x0a, 0x28, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x69,
+	0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x76, 0x31, 0x42, 0x11,
+	0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x64, 0x61, 0x69, 0x73, 0x68, 0x65, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63,
+	0x6d, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x69, 0x6d, 0x70, 0x6f,
+	0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6d, 0x70, 0x6f,
+	0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x49,
+	0xaa, 0x02, 0x24, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x24, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x6f, 0x72, 0x63, 0x6d, 0x64, 0x5c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5c, 0x49,
+	0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x30, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x5c, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5c, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x64, 0x61,
+	0x74, 0x61, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x27, 0x49, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x6f, 0x72, 0x63, 0x6d, 0x64, 0x3a,
+	0x3a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x70, 0x6f, 0x73,
+	0x74, 0x6f, 0x72, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -204,17 +1651,55 @@ func file_internal_impostordata_v1_impostordata_proto_rawDescGZIP() []byte {
 	return file_internal_impostordata_v1_impostordata_proto_rawDescData
 }
 
-var file_internal_impostordata_v1_impostordata_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_internal_impostordata_v1_impostordata_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_internal_impostordata_v1_impostordata_proto_goTypes = []interface{}{
-	(*ObjectVersion)(nil),    // 0: impostorcmd.internal.impostordata.v1.ObjectVersion
-	(*TargetDescriptor)(nil), // 1: impostorcmd.internal.impostordata.v1.TargetDescriptor
+	(*ObjectVersion)(nil),       // 0: impostorcmd.internal.impostordata.v1.ObjectVersion
+	(*TargetDescriptor)(nil),    // 1: impostorcmd.internal.impostordata.v1.TargetDescriptor
+	(*Rule)(nil),                // 2: impostorcmd.internal.impostordata.v1.Rule
+	(*RuleMatch)(nil),           // 3: impostorcmd.internal.impostordata.v1.RuleMatch
+	(*BuiltinHandler)(nil),      // 4: impostorcmd.internal.impostordata.v1.BuiltinHandler
+	(*ArgumentRewrite)(nil),     // 5: impostorcmd.internal.impostordata.v1.ArgumentRewrite
+	(*InsertArgs)(nil),          // 6: impostorcmd.internal.impostordata.v1.InsertArgs
+	(*DropArgs)(nil),            // 7: impostorcmd.internal.impostordata.v1.DropArgs
+	(*ReplaceArgs)(nil),         // 8: impostorcmd.internal.impostordata.v1.ReplaceArgs
+	(*RemapSubcommand)(nil),     // 9: impostorcmd.internal.impostordata.v1.RemapSubcommand
+	(*ScriptHandler)(nil),       // 10: impostorcmd.internal.impostordata.v1.ScriptHandler
+	(*ImpostorPin)(nil),         // 11: impostorcmd.internal.impostordata.v1.ImpostorPin
+	(*Provenance)(nil),          // 12: impostorcmd.internal.impostordata.v1.Provenance
+	(*FileFingerprint)(nil),     // 13: impostorcmd.internal.impostordata.v1.FileFingerprint
+	(*FileIdentity)(nil),        // 14: impostorcmd.internal.impostordata.v1.FileIdentity
+	(*FileOwner)(nil),           // 15: impostorcmd.internal.impostordata.v1.FileOwner
+	(*DescriptorSignature)(nil), // 16: impostorcmd.internal.impostordata.v1.DescriptorSignature
+	nil,                         // 17: impostorcmd.internal.impostordata.v1.TargetDescriptor.EnvEntry
+	nil,                         // 18: impostorcmd.internal.impostordata.v1.RuleMatch.EnvEqualsEntry
+	nil,                         // 19: impostorcmd.internal.impostordata.v1.BuiltinHandler.OptionsEntry
 }
 var file_internal_impostordata_v1_impostordata_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	13, // 0: impostorcmd.internal.impostordata.v1.TargetDescriptor.original_fingerprint:type_name -> impostorcmd.internal.impostordata.v1.FileFingerprint
+	12, // 1: impostorcmd.internal.impostordata.v1.TargetDescriptor.provenance:type_name -> impostorcmd.internal.impostordata.v1.Provenance
+	11, // 2: impostorcmd.internal.impostordata.v1.TargetDescriptor.impostor_pin:type_name -> impostorcmd.internal.impostordata.v1.ImpostorPin
+	4,  // 3: impostorcmd.internal.impostordata.v1.TargetDescriptor.builtin:type_name -> impostorcmd.internal.impostordata.v1.BuiltinHandler
+	10, // 4: impostorcmd.internal.impostordata.v1.TargetDescriptor.script:type_name -> impostorcmd.internal.impostordata.v1.ScriptHandler
+	17, // 5: impostorcmd.internal.impostordata.v1.TargetDescriptor.env:type_name -> impostorcmd.internal.impostordata.v1.TargetDescriptor.EnvEntry
+	2,  // 6: impostorcmd.internal.impostordata.v1.TargetDescriptor.rules:type_name -> impostorcmd.internal.impostordata.v1.Rule
+	3,  // 7: impostorcmd.internal.impostordata.v1.Rule.match:type_name -> impostorcmd.internal.impostordata.v1.RuleMatch
+	4,  // 8: impostorcmd.internal.impostordata.v1.Rule.builtin:type_name -> impostorcmd.internal.impostordata.v1.BuiltinHandler
+	10, // 9: impostorcmd.internal.impostordata.v1.Rule.script:type_name -> impostorcmd.internal.impostordata.v1.ScriptHandler
+	11, // 10: impostorcmd.internal.impostordata.v1.Rule.impostor_pin:type_name -> impostorcmd.internal.impostordata.v1.ImpostorPin
+	18, // 11: impostorcmd.internal.impostordata.v1.RuleMatch.env_equals:type_name -> impostorcmd.internal.impostordata.v1.RuleMatch.EnvEqualsEntry
+	19, // 12: impostorcmd.internal.impostordata.v1.BuiltinHandler.options:type_name -> impostorcmd.internal.impostordata.v1.BuiltinHandler.OptionsEntry
+	5,  // 13: impostorcmd.internal.impostordata.v1.BuiltinHandler.rewrites:type_name -> impostorcmd.internal.impostordata.v1.ArgumentRewrite
+	6,  // 14: impostorcmd.internal.impostordata.v1.ArgumentRewrite.insert:type_name -> impostorcmd.internal.impostordata.v1.InsertArgs
+	7,  // 15: impostorcmd.internal.impostordata.v1.ArgumentRewrite.drop:type_name -> impostorcmd.internal.impostordata.v1.DropArgs
+	8,  // 16: impostorcmd.internal.impostordata.v1.ArgumentRewrite.replace:type_name -> impostorcmd.internal.impostordata.v1.ReplaceArgs
+	9,  // 17: impostorcmd.internal.impostordata.v1.ArgumentRewrite.remap_subcommand:type_name -> impostorcmd.internal.impostordata.v1.RemapSubcommand
+	15, // 18: impostorcmd.internal.impostordata.v1.FileFingerprint.owner:type_name -> impostorcmd.internal.impostordata.v1.FileOwner
+	14, // 19: impostorcmd.internal.impostordata.v1.FileFingerprint.identity:type_name -> impostorcmd.internal.impostordata.v1.FileIdentity
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_internal_impostordata_v1_impostordata_proto_init() }
@@ -247,6 +1732,193 @@ func file_internal_impostordata_v1_impostordata_proto_init() {
 				return nil
 			}
 		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuiltinHandler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgumentRewrite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplaceArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemapSubcommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScriptHandler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImpostorPin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Provenance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileFingerprint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileOwner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_impostordata_v1_impostordata_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptorSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_impostordata_v1_impostordata_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_internal_impostordata_v1_impostordata_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*ArgumentRewrite_Insert)(nil),
+		(*ArgumentRewrite_Drop)(nil),
+		(*ArgumentRewrite_Replace)(nil),
+		(*ArgumentRewrite_RemapSubcommand)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -254,7 +1926,7 @@ func file_internal_impostordata_v1_impostordata_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_impostordata_v1_impostordata_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},